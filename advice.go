@@ -0,0 +1,101 @@
+package bstree
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Action is a change to a tree recommended by Advise
+type Action string
+
+const (
+	// ActionRebalance rebuilds the tree with minimal depth, e.g. with
+	// StartMaintenance, WithAutoRebalance or a balanced copy from Filter
+	ActionRebalance Action = "rebalance"
+	// ActionUseLLRB switches to the self-balancing LLRB backend, see WithBalancing
+	ActionUseLLRB Action = "use-llrb"
+	// ActionUseBloomFilter rejects absent values early, see WithBloomFilter
+	ActionUseBloomFilter Action = "use-bloom-filter"
+)
+
+const (
+	// adviceMinGain is the smallest estimated gain worth a recommendation
+	adviceMinGain = 0.2
+	// adviceMinLookups is the number of counted lookups needed to judge the workload
+	adviceMinLookups = 100
+	// adviceBloomFalsePositives is the false positive rate of a bloom filter with 10 bits per value
+	adviceBloomFalsePositives = 0.01
+)
+
+// Recommendation is a single action recommended by Advise
+type Recommendation struct {
+	Action Action  `json:"action"`
+	Reason string  `json:"reason"`
+	Gain   float64 `json:"gain"` // estimated fraction of the cost of Exists saved, between 0 and 1
+}
+
+// Advice describes the state of a tree and how to improve its performance
+type Advice struct {
+	Profile         Profile          `json:"profile"`
+	AverageDepth    float64          `json:"average_depth"`   // average number of nodes visited when finding a stored value
+	OptimalDepth    float64          `json:"optimal_depth"`   // the same for a tree of minimal depth
+	Recommendations []Recommendation `json:"recommendations"` // largest gain first
+}
+
+// Advise examines the shape and usage of the tree and recommends changes
+// The gains are estimates based on the number of nodes a lookup visits.
+// Recommendations based on the workload, like the bloom filter, need the
+// tree to be created WithCounters, and reflect all lookups since then.
+// Time-complexity: O(size)
+func (tree *Tree) Advise() Advice {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	advice := Advice{
+		Profile:         tree.profile(),
+		OptimalDepth:    optimalDepth(tree.size),
+		Recommendations: []Recommendation{},
+	}
+	profile := advice.Profile
+	for depth, nodes := range profile.Levels {
+		advice.AverageDepth += float64((depth + 1) * nodes)
+	}
+	if tree.size > 0 {
+		advice.AverageDepth /= float64(tree.size)
+	}
+	recommend := func(action Action, gain float64, reason string) {
+		if gain >= adviceMinGain {
+			advice.Recommendations = append(advice.Recommendations, Recommendation{Action: action, Reason: reason, Gain: gain})
+		}
+	}
+	if tree.balancing == Unbalanced && tree.size > 0 {
+		gain := 1 - advice.OptimalDepth/advice.AverageDepth
+		recommend(ActionRebalance, gain, fmt.Sprintf("lookups visit %.1f nodes on average, %.1f after rebalancing", advice.AverageDepth, advice.OptimalDepth))
+		// A tree grown by inserts degenerates again after a rebalance
+		if tree.autoRebalance == 0 && profile.Inserts >= int64(tree.size) {
+			recommend(ActionUseLLRB, gain, fmt.Sprintf("the tree degenerated under %d inserts, LLRB keeps it balanced", profile.Inserts))
+		}
+	}
+	if tree.bloom == nil && profile.Lookups >= adviceMinLookups {
+		misses := float64(profile.Misses) / float64(profile.Lookups)
+		recommend(ActionUseBloomFilter, misses*(1-adviceBloomFalsePositives), fmt.Sprintf("%.0f%% of lookups find nothing", 100*misses))
+	}
+	sort.SliceStable(advice.Recommendations, func(a, b int) bool {
+		return advice.Recommendations[a].Gain > advice.Recommendations[b].Gain
+	})
+	return advice
+}
+
+// optimalDepth returns the average depth of the nodes of a tree of size values with minimal depth
+func optimalDepth(size int) float64 {
+	if size == 0 {
+		return 0
+	}
+	total := 0
+	remaining := size
+	for depth, width := 1, 1; remaining > 0; depth, width = depth+1, 2*width {
+		nodes := min(width, remaining)
+		total += depth * nodes
+		remaining -= nodes
+	}
+	return float64(total) / float64(size)
+}
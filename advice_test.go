@@ -0,0 +1,61 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_Advise(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithCounters())
+	for i := 0; i < 100; i++ {
+		tree.Insert(i)
+	}
+	for i := 0; i < 200; i++ {
+		tree.Exists(i)
+	}
+	advice := tree.Advise()
+	if expected := 50.5; expected != advice.AverageDepth {
+		t.Errorf("AverageDepth: {Expected: %.2f | Actual: %.2f}", expected, advice.AverageDepth)
+	}
+	// 1 + 2*2 + 4*3 + 8*4 + 16*5 + 32*6 + 37*7 = 580
+	if expected := 5.8; expected != advice.OptimalDepth {
+		t.Errorf("OptimalDepth: {Expected: %.2f | Actual: %.2f}", expected, advice.OptimalDepth)
+	}
+	var actions []Action
+	for _, recommendation := range advice.Recommendations {
+		actions = append(actions, recommendation.Action)
+	}
+	if expected := fmt.Sprint([]Action{ActionRebalance, ActionUseLLRB, ActionUseBloomFilter}); expected != fmt.Sprint(actions) {
+		t.Errorf("Actions: {Expected: %s | Actual: %v}", expected, actions)
+	}
+	if gain := advice.Recommendations[2].Gain; gain < 0.49 || gain > 0.5 {
+		t.Errorf("Bloom filter gain: {Expected: 0.495 | Actual: %.3f}", gain)
+	}
+}
+
+func TestTree_Advise_Balanced(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithBalancing(LLRB), WithCounters(), WithBloomFilter(10, hashInt))
+	for i := 0; i < 100; i++ {
+		tree.Insert(i)
+		tree.Exists(i + 100)
+	}
+	if advice := tree.Advise(); len(advice.Recommendations) != 0 {
+		t.Errorf("Recommendations: {Expected: [] | Actual: %+v}", advice.Recommendations)
+	}
+	if advice := EmptyTree().Advise(); len(advice.Recommendations) != 0 || advice.AverageDepth != 0 {
+		t.Errorf("Empty tree: {Expected: [] 0 | Actual: %+v %v}", advice.Recommendations, advice.AverageDepth)
+	}
+}
+
+// Ask a degenerate tree for advice
+func ExampleTree_Advise() {
+	tree := New(IntSmaller, IntLarger)
+	for i := 1; i <= 15; i++ {
+		tree.Insert(i)
+	}
+	for _, recommendation := range tree.Advise().Recommendations {
+		fmt.Printf("%s (%.0f%%): %s\n", recommendation.Action, 100*recommendation.Gain, recommendation.Reason)
+	}
+	// Output:
+	// rebalance (59%): lookups visit 8.0 nodes on average, 3.3 after rebalancing
+}
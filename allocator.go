@@ -0,0 +1,65 @@
+package bstree
+
+// Cell is the storage for a single node of a tree
+// Its contents are opaque and managed by the tree. Allocators can
+// hand out cells from one large []Cell, for example. Cells hold Go
+// pointers, so they must live in memory scanned by the garbage collector.
+type Cell _Node
+
+// Allocator provides the storage for the nodes of a tree
+type Allocator interface {
+	// Allocate returns a zeroed cell
+	Allocate() *Cell
+	// Free is called with a cell the tree no longer uses
+	Free(cell *Cell)
+}
+
+// WithAllocator makes the tree allocate its nodes using allocator
+// An allocator must not be shared between trees unless it is safe
+// for use by concurrent goroutines. Cells only fit plain nodes, so trees
+// created WithParentPointers, WithAugment, WithKeyCache or WithMerkle,
+// whose nodes carry extra metadata, allocate their nodes themselves.
+func WithAllocator(allocator Allocator) Option {
+	return func(tree *Tree) {
+		tree.allocator = allocator
+	}
+}
+
+// _SlabAllocator hands out cells from contiguous slabs and recycles freed cells
+type _SlabAllocator struct {
+	slab     []Cell
+	freelist []*Cell
+	slabSize int
+}
+
+// NewSlabAllocator creates an allocator that reserves cells slabSize at a time
+// Nodes allocated together end up next to each other in memory, and the
+// garbage collector tracks a few large slabs instead of many small nodes.
+// Freed cells are reused, but slabs are never returned to the runtime
+// while the tree is reachable.
+func NewSlabAllocator(slabSize int) Allocator {
+	if slabSize < 1 {
+		slabSize = 1
+	}
+	return &_SlabAllocator{slabSize: slabSize}
+}
+
+func (allocator *_SlabAllocator) Allocate() *Cell {
+	if n := len(allocator.freelist); n > 0 {
+		cell := allocator.freelist[n-1]
+		allocator.freelist = allocator.freelist[:n-1]
+		return cell
+	}
+	if len(allocator.slab) == 0 {
+		allocator.slab = make([]Cell, allocator.slabSize)
+	}
+	cell := &allocator.slab[0]
+	allocator.slab = allocator.slab[1:]
+	return cell
+}
+
+func (allocator *_SlabAllocator) Free(cell *Cell) {
+	// Drop the references held by the cell so they can be collected
+	*cell = Cell{}
+	allocator.freelist = append(allocator.freelist, cell)
+}
@@ -0,0 +1,64 @@
+package bstree
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// _CountingAllocator tracks the number of cells in use
+type _CountingAllocator struct {
+	inuse int
+}
+
+func (allocator *_CountingAllocator) Allocate() *Cell {
+	allocator.inuse++
+	return new(Cell)
+}
+
+func (allocator *_CountingAllocator) Free(cell *Cell) {
+	allocator.inuse--
+}
+
+func TestTree_Allocator(t *testing.T) {
+	allocator := new(_CountingAllocator)
+	tree := New(IntSmaller, IntLarger, WithAllocator(allocator))
+	for i := 0; i < 1000; i++ {
+		tree.Insert(rand.Intn(500))
+	}
+	for i := 0; i < 500; i += 2 {
+		tree.Delete(i)
+	}
+	if tree.Size() != allocator.inuse {
+		t.Errorf("Cells in use: {Expected: %d | Actual: %d}", tree.Size(), allocator.inuse)
+	}
+}
+
+func TestTree_SlabAllocator(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithAllocator(NewSlabAllocator(64)))
+	for round := 0; round < 3; round++ {
+		for i := 0; i < 1000; i++ {
+			tree.Insert(i)
+		}
+		for i := 0; i < 1000; i += 2 {
+			tree.Delete(i)
+		}
+	}
+	if expected := 500; expected != tree.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+	}
+	for i := 0; i < 1000; i++ {
+		if expected := i%2 == 1; expected != tree.Exists(i) {
+			t.Errorf("Exists(%d): {Expected: %t | Actual: %t}", i, expected, !expected)
+		}
+	}
+}
+
+// Benchmark insert performance with a slab allocator
+func BenchmarkTreeInsertSlab(b *testing.B) {
+	b.StopTimer()
+	tree := New(IntSmaller, IntLarger, WithAllocator(NewSlabAllocator(4096)))
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Insert(rand.Int())
+	}
+}
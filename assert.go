@@ -0,0 +1,48 @@
+package bstree
+
+// WithAssertions enables runtime checks that internal state is only accessed under the lock
+// Any violation panics. In addition every checked access reads or writes a
+// guard field, so running tests with -race reports unlocked access even
+// when the checks cannot observe it. Meant for tests and debugging.
+func WithAssertions() Option {
+	return func(tree *Tree) {
+		tree.assertions = true
+	}
+}
+
+// assertLocked panics if nobody holds the lock of the tree
+// Another goroutine holding the lock hides a violation, which is
+// why the guard field is read as well.
+func (tree *Tree) assertLocked() {
+	if !tree.assertions {
+		return
+	}
+	if tree.mutex.TryLock() {
+		tree.mutex.Unlock()
+		panic("bstree: tree accessed without holding its lock")
+	}
+	_ = tree.guard
+}
+
+// assertWriteLocked panics if nobody holds the write lock of the tree
+func (tree *Tree) assertWriteLocked() {
+	if !tree.assertions {
+		return
+	}
+	if tree.mutex.TryRLock() {
+		tree.mutex.RUnlock()
+		panic("bstree: tree modified without holding its write lock")
+	}
+	tree.guard++
+}
+
+// verify panics if the tree violates its invariants after a modification
+// It only does work in builds with the bstree_debug tag, as it takes O(size).
+func (tree *Tree) verify() {
+	if !debug {
+		return
+	}
+	if err := tree.check(); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,42 @@
+package bstree
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestTree_AssertionsLocked(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithAssertions())
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				tree.Insert(g*1000 + i)
+				tree.Quantile(0.5)
+				tree.Depth()
+				tree.Freeze()
+				tree.Delete(g*1000 + i/2)
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func TestTree_AssertionsUnlocked(t *testing.T) {
+	expectPanic := func(name string, f func()) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("%s without lock: {Expected: panic | Actual: none}", name)
+			}
+		}()
+		f()
+	}
+	tree := New(IntSmaller, IntLarger, WithAssertions())
+	expectPanic("insert", func() { tree.insert(1) })
+	expectPanic("values", func() { tree.values() })
+	tree.mutex.RLock()
+	expectPanic("delete under read lock", func() { tree.delete(1) })
+	tree.mutex.RUnlock()
+}
@@ -0,0 +1,88 @@
+package bstree
+
+// Aug is a user-defined summary of a subtree, e.g. the sum or maximum of its values
+type Aug interface{}
+
+// Augment computes the summary of a subtree from the value at its root
+// and the summaries of its left and right subtrees. The summary of a
+// missing subtree is nil.
+type Augment func(value interface{}, left Aug, right Aug) Aug
+
+// WithAugment makes the tree maintain a summary for every subtree
+// The summaries are kept up to date through all structural changes.
+// augment is called O(depth) times per Insert and Delete.
+func WithAugment(augment Augment) Option {
+	return func(tree *Tree) {
+		tree.augment = augment
+	}
+}
+
+// augOf returns the summary of the subtree rooted at node, which must belong to a tree with an Augment
+func augOf(node *_Node) Aug {
+	if node == nil {
+		return nil
+	}
+	return fat(node).aug
+}
+
+// Aggregate returns the summary of the whole tree
+// Returns nil if the tree is empty or has no Augment.
+// Time-complexity: O(1)
+func (tree *Tree) Aggregate() Aug {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	if tree.augment == nil {
+		return nil
+	}
+	return augOf(tree.root)
+}
+
+// AggregateRange returns the summary of all values v with lo <= v <= hi
+// The summary is assembled from the per-subtree summaries, so the Augment
+// has to be a fold of its values in order, like a sum, minimum or maximum.
+// Returns nil if no value is in range or the tree has no Augment.
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (tree *Tree) AggregateRange(lo interface{}, hi interface{}) Aug {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	if tree.augment == nil {
+		return nil
+	}
+	return tree.doAggregateRange(tree.root, lo, hi)
+}
+
+func (tree *Tree) doAggregateRange(node *_Node, lo interface{}, hi interface{}) Aug {
+	if node == nil {
+		return nil
+	}
+	switch {
+	case tree.smaller(node.value, lo):
+		return tree.doAggregateRange(node.right(), lo, hi)
+	case tree.larger(node.value, hi):
+		return tree.doAggregateRange(node.left, lo, hi)
+	}
+	return tree.augment(node.value, tree.doAggregateFrom(node.left, lo), tree.doAggregateTo(node.right(), hi))
+}
+
+// doAggregateFrom summarizes the values v >= lo in the subtree rooted at node
+func (tree *Tree) doAggregateFrom(node *_Node, lo interface{}) Aug {
+	if node == nil {
+		return nil
+	}
+	if tree.smaller(node.value, lo) {
+		return tree.doAggregateFrom(node.right(), lo)
+	}
+	return tree.augment(node.value, tree.doAggregateFrom(node.left, lo), augOf(node.right()))
+}
+
+// doAggregateTo summarizes the values v <= hi in the subtree rooted at node
+func (tree *Tree) doAggregateTo(node *_Node, hi interface{}) Aug {
+	if node == nil {
+		return nil
+	}
+	if tree.larger(node.value, hi) {
+		return tree.doAggregateTo(node.left, hi)
+	}
+	return tree.augment(node.value, augOf(node.left), tree.doAggregateTo(node.right(), hi))
+}
@@ -0,0 +1,82 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+// sumAugment maintains the sum of all values in a subtree of integers
+func sumAugment(value interface{}, left Aug, right Aug) Aug {
+	sum := value.(int)
+	if left != nil {
+		sum += left.(int)
+	}
+	if right != nil {
+		sum += right.(int)
+	}
+	return sum
+}
+
+func TestTree_Augment(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithAugment(sumAugment))
+	if actual := tree.Aggregate(); actual != nil {
+		t.Errorf("Aggregate: {Expected: <nil> | Actual: %v}", actual)
+	}
+	expected := 0
+	for i := 0; i < 1000; i++ {
+		value := (i * 7919) % 1000
+		tree.Insert(value)
+		expected += value
+	}
+	for i := 0; i < 1000; i += 3 {
+		tree.Delete(i)
+		expected -= i
+	}
+	if actual := tree.Aggregate(); expected != actual {
+		t.Errorf("Aggregate: {Expected: %d | Actual: %v}", expected, actual)
+	}
+	if actual := tree.Freeze().Thaw(WithAugment(sumAugment)).Aggregate(); expected != actual {
+		t.Errorf("Aggregate after Thaw: {Expected: %d | Actual: %v}", expected, actual)
+	}
+}
+
+func TestTree_AggregateRange(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithAugment(sumAugment))
+	for i := 0; i < 200; i++ {
+		tree.Insert((i * 7919) % 200)
+	}
+	for lo := -5; lo < 205; lo += 7 {
+		for hi := lo - 3; hi < 205; hi += 11 {
+			expected := 0
+			for i := lo; i <= hi; i++ {
+				if i >= 0 && i < 200 {
+					expected += i
+				}
+			}
+			actual := tree.AggregateRange(lo, hi)
+			if actual == nil {
+				actual = 0
+			}
+			if expected != actual {
+				t.Errorf("AggregateRange(%d, %d): {Expected: %d | Actual: %v}", lo, hi, expected, actual)
+			}
+		}
+	}
+	if actual := CompleteTree(10).AggregateRange(1, 10); actual != nil {
+		t.Errorf("AggregateRange without Augment: {Expected: <nil> | Actual: %v}", actual)
+	}
+}
+
+// Maintain the sum of all values in the tree
+func ExampleWithAugment() {
+	tree := New(IntSmaller, IntLarger, WithAugment(sumAugment))
+	tree.Insert(10)
+	tree.Insert(5)
+	tree.Insert(20)
+	tree.Delete(10)
+	fmt.Println(tree.Aggregate())
+	fmt.Println(tree.AggregateRange(5, 15))
+	// Output:
+	// 25
+	// 5
+}
@@ -0,0 +1,94 @@
+package bstree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// Every benchmark below runs once per backend and shape, with names like
+// BenchmarkExists/LLRB/Sequential, so results of different revisions can
+// be compared with benchstat.
+
+const benchSize = 4096
+
+// shapes lists the orders in which the benchmark trees are loaded
+var shapes = []struct {
+	name   string
+	values func(n int) []int
+}{
+	{"Random", rand.Perm},
+	{"Sequential", func(n int) []int {
+		values := make([]int, n)
+		for i := range values {
+			values[i] = i
+		}
+		return values
+	}},
+}
+
+// forEachShape runs bench for every backend and shape with a freshly loaded tree
+func forEachShape(b *testing.B, bench func(b *testing.B, tree *Tree)) {
+	for _, backend := range backends {
+		for _, shape := range shapes {
+			b.Run(fmt.Sprintf("%s/%s", backend.name, shape.name), func(b *testing.B) {
+				tree := New(IntSmaller, IntLarger, WithBalancing(backend.balancing))
+				for _, value := range shape.values(benchSize) {
+					tree.Insert(value)
+				}
+				b.ResetTimer()
+				bench(b, tree)
+			})
+		}
+	}
+}
+
+// Benchmark lookups of present and absent values
+func BenchmarkExists(b *testing.B) {
+	forEachShape(b, func(b *testing.B, tree *Tree) {
+		for i := 0; i < b.N; i++ {
+			tree.Exists(rand.Intn(2 * benchSize))
+		}
+	})
+}
+
+// Benchmark full in-order traversals
+func BenchmarkTraverse(b *testing.B) {
+	forEachShape(b, func(b *testing.B, tree *Tree) {
+		for i := 0; i < b.N; i++ {
+			tree.Traverse(InOrder, func(value interface{}) {})
+		}
+	})
+}
+
+// Benchmark inserting and deleting a value
+func BenchmarkInsertDelete(b *testing.B) {
+	forEachShape(b, func(b *testing.B, tree *Tree) {
+		for i := 0; i < b.N; i++ {
+			value := benchSize + rand.Intn(benchSize)
+			tree.Insert(value)
+			tree.Delete(value)
+		}
+	})
+}
+
+// Benchmark mixed workloads with the given percentage of writes
+func BenchmarkMixed(b *testing.B) {
+	for _, writes := range []int{1, 10, 50} {
+		b.Run(fmt.Sprintf("Writes%d", writes), func(b *testing.B) {
+			forEachShape(b, func(b *testing.B, tree *Tree) {
+				for i := 0; i < b.N; i++ {
+					value := rand.Intn(2 * benchSize)
+					switch {
+					case rand.Intn(100) >= writes:
+						tree.Exists(value)
+					case rand.Intn(2) == 0:
+						tree.Insert(value)
+					default:
+						tree.Delete(value)
+					}
+				}
+			})
+		})
+	}
+}
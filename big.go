@@ -0,0 +1,26 @@
+package bstree
+
+import "math/big"
+
+// Arbitrary-precision versions of Smaller and Larger for *big.Int values
+// The tree stores the pointers, so values must not be modified after they were inserted.
+func BigIntSmaller(value interface{}, other interface{}) bool {
+	return value.(*big.Int).Cmp(other.(*big.Int)) < 0
+}
+
+func BigIntLarger(value interface{}, other interface{}) bool {
+	return value.(*big.Int).Cmp(other.(*big.Int)) > 0
+}
+
+// Arbitrary-precision versions of Smaller and Larger for *big.Float values
+// Values compare by their numeric value regardless of precision, so 1.5
+// at 53 and at 200 bits of precision are equal. NaN cannot be represented
+// by big.Float. The tree stores the pointers, so values must not be
+// modified after they were inserted.
+func BigFloatSmaller(value interface{}, other interface{}) bool {
+	return value.(*big.Float).Cmp(other.(*big.Float)) < 0
+}
+
+func BigFloatLarger(value interface{}, other interface{}) bool {
+	return value.(*big.Float).Cmp(other.(*big.Float)) > 0
+}
@@ -0,0 +1,42 @@
+package bstree
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func TestBigComparators(t *testing.T) {
+	ints := New(BigIntSmaller, BigIntLarger)
+	huge := new(big.Int).Lsh(big.NewInt(1), 200)
+	for _, value := range []*big.Int{huge, big.NewInt(-1), new(big.Int).Neg(huge), big.NewInt(0)} {
+		ints.Insert(value)
+	}
+	if ints.Maximum().(*big.Int).Cmp(huge) != 0 {
+		t.Errorf("Maximum: {Expected: %v | Actual: %v}", huge, ints.Maximum())
+	}
+	if !ints.Exists(new(big.Int).Set(huge)) {
+		t.Errorf("Exists(2^200): {Expected: true | Actual: false}")
+	}
+	floats := New(BigFloatSmaller, BigFloatLarger)
+	floats.Insert(big.NewFloat(1.5))
+	if floats.Insert(new(big.Float).SetPrec(200).SetFloat64(1.5)) {
+		t.Errorf("Insert(1.5 with 200 bits): {Expected: false | Actual: true}")
+	}
+	floats.Insert(new(big.Float).SetInf(true))
+	if expected := "-Inf"; floats.Minimum().(*big.Float).String() != expected {
+		t.Errorf("Minimum: {Expected: %s | Actual: %v}", expected, floats.Minimum())
+	}
+}
+
+// Order amounts beyond the range of int64
+func ExampleBigIntSmaller() {
+	tree := New(BigIntSmaller, BigIntLarger)
+	for _, s := range []string{"18446744073709551616", "-5", "9223372036854775807"} {
+		value, _ := new(big.Int).SetString(s, 10)
+		tree.Insert(value)
+	}
+	fmt.Println(tree)
+	// Output:
+	// {size: 3 | depth: 3 | min: -5 | max: 18446744073709551616 | values: [-5 9223372036854775807 18446744073709551616]}
+}
@@ -0,0 +1,103 @@
+package bstree
+
+import "math"
+
+// minBloomCapacity is the smallest number of values a bloom filter is sized for
+const minBloomCapacity = 64
+
+// _Bloom is a bloom filter over the values of a tree
+// It only ever gains bits: deleted values stay in the filter until it is
+// rebuilt, which happens whenever it has taken as many values as it was
+// sized for.
+type _Bloom struct {
+	bits       []uint64
+	hashes     int // number of bits set per value
+	bitsPerKey int
+	hash       func(value interface{}) uint64
+	count      int // values added since the last reset
+	capacity   int // values the filter is sized for
+}
+
+// WithBloomFilter makes Exists reject most absent values without searching the tree
+// hash has to return equal hashes for values that compare equal. The
+// filter uses about bitsPerKey bits per value, e.g. 10 bits give a false
+// positive rate of about 1%, and it never rejects a stored value. Deleted
+// values keep their bits until the filter is rebuilt; it is resized to
+// twice the size of the tree whenever it fills up, so each rebuild is paid
+// for by the inserts that preceded it.
+func WithBloomFilter(bitsPerKey int, hash func(value interface{}) uint64) Option {
+	return func(tree *Tree) {
+		if bitsPerKey > 0 {
+			hashes := int(math.Round(float64(bitsPerKey) * math.Ln2))
+			tree.bloom = &_Bloom{hashes: min(max(hashes, 1), 30), bitsPerKey: bitsPerKey, hash: hash}
+			tree.bloom.reset(0)
+		}
+	}
+}
+
+// reset empties the filter and sizes it for n values
+func (bloom *_Bloom) reset(n int) {
+	if bloom == nil {
+		return
+	}
+	bloom.capacity = max(2*n, minBloomCapacity)
+	words := (bloom.capacity*bloom.bitsPerKey + 63) / 64
+	if cap(bloom.bits) >= words && cap(bloom.bits) <= 2*words {
+		bloom.bits = bloom.bits[:words]
+		clear(bloom.bits)
+	} else {
+		bloom.bits = make([]uint64, words)
+	}
+	bloom.count = 0
+}
+
+// add sets the bits of value
+func (bloom *_Bloom) add(value interface{}) {
+	if bloom == nil {
+		return
+	}
+	h, delta, m := bloom.probe(value)
+	for i := 0; i < bloom.hashes; i++ {
+		bit := h % m
+		bloom.bits[bit/64] |= 1 << (bit % 64)
+		h += delta
+	}
+	bloom.count++
+}
+
+// mayContain checks if all bits of value are set
+// It is true for every added value and, rarely, for others.
+func (bloom *_Bloom) mayContain(value interface{}) bool {
+	if bloom == nil {
+		return true
+	}
+	h, delta, m := bloom.probe(value)
+	for i := 0; i < bloom.hashes; i++ {
+		bit := h % m
+		if bloom.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+		h += delta
+	}
+	return true
+}
+
+// probe returns the first bit position, the step between positions and the number of bits
+// The positions are derived from a single hash by double hashing.
+func (bloom *_Bloom) probe(value interface{}) (uint64, uint64, uint64) {
+	h := bloom.hash(value)
+	delta := h>>33 | h<<31 | 1
+	return h, delta, uint64(len(bloom.bits)) * 64
+}
+
+// full checks if the filter has taken as many values as it was sized for
+func (bloom *_Bloom) full() bool {
+	return bloom != nil && bloom.count >= bloom.capacity
+}
+
+// rebuildBloom resizes the bloom filter to the tree and adds all values again
+// Time-complexity: O(size)
+func (tree *Tree) rebuildBloom() {
+	tree.bloom.reset(tree.size)
+	tree.doInOrder(tree.root, tree.bloom.add)
+}
@@ -0,0 +1,88 @@
+package bstree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// hashInt mixes the bits of an int with the finalizer of splitmix64
+func hashInt(value interface{}) uint64 {
+	h := uint64(value.(int))
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+	return h
+}
+
+func TestWithBloomFilter(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend, WithBloomFilter(10, hashInt))
+		stored := make(map[int]bool)
+		for _, value := range rand.Perm(20000)[:5000] {
+			tree.Insert(value)
+			stored[value] = true
+		}
+		for value := range stored {
+			if value%3 == 0 {
+				tree.Delete(value)
+				delete(stored, value)
+			}
+		}
+		mustCheck(t, tree)
+		falsePositives := 0
+		for value := 0; value < 20000; value++ {
+			if actual := tree.Exists(value); actual != stored[value] {
+				t.Errorf("Exists(%d): {Expected: %t | Actual: %t}", value, stored[value], actual)
+			}
+			if !stored[value] && tree.bloom.mayContain(value) {
+				falsePositives++
+			}
+		}
+		// Deleted values stay in the filter until it is rebuilt
+		if rate := float64(falsePositives) / float64(20000-len(stored)); rate > 0.2 {
+			t.Errorf("False positive rate: {Expected: <= 0.2 | Actual: %.3f}", rate)
+		}
+	})
+}
+
+func TestWithBloomFilter_Rebuild(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithBloomFilter(10, hashInt))
+	for i := 0; i < 1000; i++ {
+		tree.Insert(i)
+	}
+	// Churn at a steady size until the filter has been rebuilt without 0-999
+	for i := 0; i < 2000; i++ {
+		tree.Delete(i)
+		tree.Insert(i + 1000)
+	}
+	falsePositives := 0
+	for i := 0; i < 1000; i++ {
+		if tree.bloom.mayContain(i) {
+			falsePositives++
+		}
+	}
+	if falsePositives > 50 {
+		t.Errorf("False positives among deleted values: {Expected: <= 50 | Actual: %d}", falsePositives)
+	}
+	filtered := tree.Filter(func(value interface{}) bool { return value.(int)%2 == 0 })
+	mustCheck(t, filtered)
+	if filtered.bloom == nil {
+		t.Errorf("Filter: {Expected: bloom filter | Actual: <nil>}")
+	}
+}
+
+// Reject absent values without searching the tree
+func ExampleWithBloomFilter() {
+	tree := New(IntSmaller, IntLarger, WithBloomFilter(10, hashInt))
+	for _, value := range []int{5, 2, 8} {
+		tree.Insert(value)
+	}
+	fmt.Println(tree.Exists(8))
+	fmt.Println(tree.Exists(3))
+	// Output:
+	// true
+	// false
+}
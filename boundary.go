@@ -0,0 +1,55 @@
+package bstree
+
+// doBoundary visits the perimeter of the tree counter-clockwise
+// First the root, then the left boundary top-down, then all leaves from
+// left to right, then the right boundary bottom-up. The boundaries follow
+// the left (right) child where there is one and the other child otherwise,
+// and exclude leaves, so every node is visited at most once.
+func (tree *Tree) doBoundary(visitor func(node *_Node, depth int)) {
+	root := tree.root
+	if root == nil {
+		return
+	}
+	visitor(root, 0)
+	if root.left == nil && root.right() == nil {
+		return
+	}
+	// Left boundary, top-down
+	depth := 1
+	for node := root.left; node != nil && (node.left != nil || node.right() != nil); depth++ {
+		visitor(node, depth)
+		if node.left != nil {
+			node = node.left
+		} else {
+			node = node.right()
+		}
+	}
+	tree.doLeaves(root.left, 1, visitor)
+	tree.doLeaves(root.right(), 1, visitor)
+	// Right boundary, bottom-up
+	var right []*_Node
+	for node := root.right(); node != nil && (node.left != nil || node.right() != nil); {
+		right = append(right, node)
+		if node.right() != nil {
+			node = node.right()
+		} else {
+			node = node.left
+		}
+	}
+	for i := len(right) - 1; i >= 0; i-- {
+		visitor(right[i], i+1)
+	}
+}
+
+// doLeaves visits the leaves of the subtree rooted at node from left to right
+func (tree *Tree) doLeaves(node *_Node, depth int, visitor func(node *_Node, depth int)) {
+	if node == nil {
+		return
+	}
+	if node.left == nil && node.right() == nil {
+		visitor(node, depth)
+		return
+	}
+	tree.doLeaves(node.left, depth+1, visitor)
+	tree.doLeaves(node.right(), depth+1, visitor)
+}
@@ -0,0 +1,50 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_BoundaryOrder(t *testing.T) {
+	for _, test := range []struct {
+		values   []int
+		expected string
+	}{
+		{nil, "[]"},
+		{[]int{1}, "[1]"},
+		{[]int{1, 2, 3}, "[1 3 2]"},
+		{[]int{8, 4, 12, 2, 6, 10, 14, 5, 7, 11}, "[8 4 2 5 7 11 14 12]"},
+		{[]int{8, 4, 3, 2, 6, 12, 10, 9}, "[8 4 3 2 6 9 10 12]"},
+	} {
+		tree := New(IntSmaller, IntLarger)
+		for _, value := range test.values {
+			tree.Insert(value)
+		}
+		actual := []interface{}{}
+		tree.Traverse(BoundaryOrder, func(value interface{}) {
+			actual = append(actual, value)
+		})
+		if test.expected != fmt.Sprint(actual) {
+			t.Errorf("Traverse(BoundaryOrder) of %v: {Expected: %s | Actual: %v}", test.values, test.expected, actual)
+		}
+	}
+	tree := CompleteTree(7)
+	var depths []int
+	tree.TraverseNodes(BoundaryOrder, func(value interface{}, depth int, isLeaf bool) {
+		depths = append(depths, depth)
+	})
+	if expected := "[0 1 2 2 2 2 1]"; expected != fmt.Sprint(depths) {
+		t.Errorf("TraverseNodes(BoundaryOrder) depths: {Expected: %s | Actual: %v}", expected, depths)
+	}
+}
+
+// Walk around the perimeter of a tree
+func ExampleTree_Traverse_boundary() {
+	tree := CompleteTree(7)
+	tree.Traverse(BoundaryOrder, func(value interface{}) {
+		fmt.Print(value, ",")
+	})
+	fmt.Println()
+	// Output:
+	// 4,2,1,3,5,7,6,
+}
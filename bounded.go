@@ -0,0 +1,29 @@
+package bstree
+
+// MinimumAtLeast returns the smallest value larger than or equal to lo
+// It reports false if every value is smaller than lo.
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (tree *Tree) MinimumAtLeast(lo interface{}) (interface{}, bool) {
+	defer tree.recoverPanic()
+	if tree.checkType(lo) != nil {
+		return nil, false
+	}
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	return valueOf(tree.ceiling(lo))
+}
+
+// MaximumAtMost returns the largest value smaller than or equal to hi
+// It reports false if every value is larger than hi.
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (tree *Tree) MaximumAtMost(hi interface{}) (interface{}, bool) {
+	defer tree.recoverPanic()
+	if tree.checkType(hi) != nil {
+		return nil, false
+	}
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	return valueOf(tree.floor(hi))
+}
@@ -0,0 +1,69 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_MinimumAtLeast(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend)
+		for i := 0; i < 100; i += 10 {
+			tree.Insert(i)
+		}
+		for lo := -5; lo <= 95; lo++ {
+			value, ok := tree.MinimumAtLeast(lo)
+			expected := (lo + 9) / 10 * 10
+			if lo < 0 {
+				expected = 0
+			}
+			if expected > 90 {
+				if ok {
+					t.Errorf("MinimumAtLeast(%d): {Expected: <nil> false | Actual: %v %t}", lo, value, ok)
+				}
+			} else if !ok || value != expected {
+				t.Errorf("MinimumAtLeast(%d): {Expected: %d true | Actual: %v %t}", lo, expected, value, ok)
+			}
+		}
+	})
+}
+
+func TestTree_MaximumAtMost(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend)
+		for i := 0; i < 100; i += 10 {
+			tree.Insert(i)
+		}
+		for hi := -5; hi <= 105; hi++ {
+			value, ok := tree.MaximumAtMost(hi)
+			if hi < 0 {
+				if ok {
+					t.Errorf("MaximumAtMost(%d): {Expected: <nil> false | Actual: %v %t}", hi, value, ok)
+				}
+				continue
+			}
+			expected := hi / 10 * 10
+			if expected > 90 {
+				expected = 90
+			}
+			if !ok || value != expected {
+				t.Errorf("MaximumAtMost(%d): {Expected: %d true | Actual: %v %t}", hi, expected, value, ok)
+			}
+		}
+	})
+}
+
+// Find the extremes of the values on one side of a bound
+func ExampleTree_MinimumAtLeast() {
+	tree := New(IntSmaller, IntLarger)
+	for _, value := range []int{10, 20, 30} {
+		tree.Insert(value)
+	}
+	fmt.Println(tree.MinimumAtLeast(15))
+	fmt.Println(tree.MaximumAtMost(15))
+	fmt.Println(tree.MinimumAtLeast(31))
+	// Output:
+	// 20 true
+	// 10 true
+	// <nil> false
+}
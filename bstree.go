@@ -33,17 +33,16 @@
         fmt.Println(value)
       })
     }
-
-  TODO:
-    Implement one-time rebalancing of the tree.
-    Implement deletion.
 */
 package bstree
 
 import (
-	"container/list"
 	"fmt"
+	"reflect"
+	"strings"
 	"sync"
+	"time"
+	"unsafe"
 )
 
 // The Smaller and Larger interfaces
@@ -59,56 +58,201 @@ func IntLarger(value interface{}, other interface{}) bool {
 	return int(value.(int)) > int(other.(int))
 }
 
+// Comparable is implemented by values that carry their own ordering
+// CompareTo returns a negative number, zero or a positive number when
+// the value is smaller than, equal to or larger than other respectively.
+type Comparable interface {
+	CompareTo(other interface{}) int
+}
+
+// Comparable versions of Smaller and Larger
+func ComparableSmaller(value interface{}, other interface{}) bool {
+	return value.(Comparable).CompareTo(other) < 0
+}
+
+func ComparableLarger(value interface{}, other interface{}) bool {
+	return value.(Comparable).CompareTo(other) > 0
+}
+
 // _Node represents a single element in the tree
 type _Node struct {
-	value interface{}
-	left  *_Node
-	right *_Node
+	value  interface{}
+	left   *_Node
+	link   *_Node // right child, or the in-order successor if thread is set, see right
+	size   int    // number of nodes in the subtree rooted here
+	red    bool   // color of the link from the parent, for LLRB trees
+	thread bool   // link is a thread rather than a child, only for trees created WithThreads
+}
+
+// _FatNode is a node with room for the metadata of optional features
+// Trees that need any of it allocate all their nodes as _FatNode and reach
+// the metadata through fat, so trees without these features don't pay for
+// it. The node comes first, so a *_Node of such a tree points to the start
+// of its _FatNode.
+type _FatNode struct {
+	_Node
+	parent *_Node // only maintained for trees created WithParentPointers
+	aug    Aug    // user-defined summary of the subtree rooted here
+	pre    uint64 // cached pre-key of the value, see WithKeyCache
+	digest uint64 // sum of the value digests of the subtree rooted here, see WithMerkle
+}
+
+// fat returns the metadata of a node, which must belong to a tree with fat nodes
+func fat(node *_Node) *_FatNode {
+	return (*_FatNode)(unsafe.Pointer(node))
+}
+
+// fatNodes reports whether the tree keeps per-node metadata and allocates _FatNode
+func (tree *Tree) fatNodes() bool {
+	return tree.parents || tree.augment != nil || tree.keyCache != nil || tree.merkle != nil
+}
+
+// right returns the right child of node, or nil if it has none
+func (node *_Node) right() *_Node {
+	if node.thread {
+		return nil
+	}
+	return node.link
+}
+
+// setRight makes child the right child of node
+// Trees created WithThreads keep a thread to next, the in-order successor
+// of node, in place of a missing child.
+func (tree *Tree) setRight(node *_Node, child *_Node, next *_Node) {
+	if child == nil && tree.threads {
+		node.link, node.thread = next, true
+		return
+	}
+	node.link, node.thread = child, false
 }
 
 func new_Node(value interface{}) *_Node {
 	node := new(_Node)
 	node.value = value
+	node.size = 1
 	return node
 }
 
+// sizeOf returns the number of nodes in the subtree rooted at node
+func sizeOf(node *_Node) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
 func (node *_Node) String() string {
-	return fmt.Sprintf("{address: %p | value: %v | left: %p | right: %p}", node, node.value, node.left, node.right)
+	return fmt.Sprintf("{address: %p | value: %v | left: %p | right: %p}", node, node.value, node.left, node.right())
 }
 
 // Tree represents a binary search tree
 // You can create a initialized Tree using bstree.New(...)
 type Tree struct {
-	root    *_Node
-	smaller Smaller
-	larger  Larger
-	size    int
-	mutex   sync.RWMutex
+	root          *_Node
+	smaller       Smaller
+	larger        Larger
+	compare       Compare // three-way version of smaller and larger used by the hot paths
+	recovery      func(err error)
+	size          int
+	mutex         sync.RWMutex
+	wal           *_WAL
+	encoder       Encoder
+	stringer      func(value interface{}) string
+	key           func(value interface{}) interface{}
+	keySmaller    Smaller
+	keyLarger     Larger
+	hooks         _Hooks
+	augment       Augment
+	allocator     Allocator
+	duplicates    bool
+	parents       bool // nodes point to their parents
+	threads       bool // nodes point to their in-order neighbours
+	fifo          bool // Delete removes the earliest inserted of equal values
+	balancing     Balancing
+	autoRebalance float64 // depth threshold relative to log2(size), 0 if disabled
+	maintenance   *_Maintenance
+	counters      *_Counters
+	slowLog       *_SlowLog
+	opTimeout     time.Duration // how long Insert, Delete and Exists wait for the lock, 0 if forever
+	lockStats     *_LockStats
+	sizer         Sizer
+	buffer        *_InsertBuffer
+	bloom         *_Bloom
+	keyCache      func(value interface{}) uint64
+	merkle        func(value interface{}) []byte // encodes values for subtree digests
+	copyBytes     bool
+	assertions    bool
+	guard         int // touched by assertions so the race detector sees unlocked access
+	snapshot      *_Snapshot
+	valueType     reflect.Type
+	nilPolicy     NilPolicy
+	version       uint64      // incremented on every modification
+	minimum       interface{} // cached smallest value
+	maximum       interface{} // cached largest value
 }
 
+// Option configures optional behaviour of a tree at construction time
+type Option func(*Tree)
+
 // New creates an initialized tree
 // Time-complexity: O(1)
-func New(smaller Smaller, larger Larger) *Tree {
+func New(smaller Smaller, larger Larger, options ...Option) *Tree {
+	return newTree(smaller, larger, nil, options...)
+}
+
+// newTree creates an initialized tree using a native three-way comparator, if there is one
+// Without one, compare is derived from smaller and larger.
+func newTree(smaller Smaller, larger Larger, compare Compare, options ...Option) *Tree {
 	tree := new(Tree)
 	tree.smaller = smaller
 	tree.larger = larger
+	tree.compare = compare
+	if compare == nil {
+		tree.compare = deriveCompare(smaller, larger)
+	}
+	tree.assertions = debug
+	for _, option := range options {
+		option(tree)
+	}
 	return tree
 }
 
+// NewComparable creates an initialized tree of values implementing Comparable
+// Time-complexity: O(1)
+func NewComparable(options ...Option) *Tree {
+	return newTree(ComparableSmaller, ComparableLarger, func(value interface{}, other interface{}) int {
+		return value.(Comparable).CompareTo(other)
+	}, options...)
+}
+
 // Size returns the size of the tree
 // Time-complexity: O(1)
 func (tree *Tree) Size() int {
 	tree.mutex.RLock()
 	defer tree.mutex.RUnlock()
-	return tree.size
+	return tree.size + tree.buffer.len()
 }
 
-// String returns the details of the tree as a string
-// Time-complexity: O(1)
+// String returns a summary of the tree suitable for logs
+// It shows the size, depth, minimum, maximum and the first few values.
+// Time-complexity: O(size)
 func (tree *Tree) String() string {
-	tree.mutex.RLock()
+	tree.rlock()
 	defer tree.mutex.RUnlock()
-	return fmt.Sprintf("{root: %p | size: %d}", tree.root, tree.size)
+	if tree.size == 0 {
+		return "{size: 0}"
+	}
+	values := make([]string, 0, stringValues+1)
+	tree.doAscend(tree.root, Range{}, func(value interface{}) bool {
+		if len(values) == stringValues {
+			values = append(values, "...")
+			return false
+		}
+		values = append(values, tree.format(value))
+		return true
+	})
+	return fmt.Sprintf("{size: %d | depth: %d | min: %s | max: %s | values: [%s]}",
+		tree.size, tree.doDepth(tree.root), tree.format(tree.minimum), tree.format(tree.maximum), strings.Join(values, " "))
 }
 
 // Traversal Algorithms
@@ -121,22 +265,44 @@ const (
 	InOrder
 	PostOrder
 	LevelOrder
+	ZigZagOrder   // level order, alternating between left to right and right to left, starting with left to right
+	BoundaryOrder // the perimeter: root, left boundary top-down, leaves, right boundary bottom-up
 )
 
 // Traverse walks the tree using a specified algorithm and calls visitor on each node.
 // Time-complexity: O(size)
 func (tree *Tree) Traverse(traversal Traversal, visitor Visitor) {
-	tree.mutex.RLock()
+	defer tree.recoverPanic()
+	if tree.slowLog != nil {
+		defer tree.observe("Traverse", time.Now())
+	}
+	tree.rlock()
 	defer tree.mutex.RUnlock()
+	tree.doTraverse(traversal, visitor)
+}
+
+func (tree *Tree) doTraverse(traversal Traversal, visitor Visitor) {
 	switch traversal {
 	case PreOrder:
 		tree.doPreOrder(tree.root, visitor)
 	case InOrder:
+		if tree.threads {
+			tree.doThreadedInOrder(visitor)
+			return
+		}
 		tree.doInOrder(tree.root, visitor)
 	case PostOrder:
 		tree.doPostOrder(tree.root, visitor)
 	case LevelOrder:
 		tree.doLevelOrder(visitor)
+	case ZigZagOrder:
+		tree.doZigZag(func(node *_Node, depth int) {
+			visitor(node.value)
+		})
+	case BoundaryOrder:
+		tree.doBoundary(func(node *_Node, depth int) {
+			visitor(node.value)
+		})
 	}
 }
 
@@ -146,7 +312,7 @@ func (tree *Tree) doPreOrder(node *_Node, visitor Visitor) {
 	}
 	visitor(node.value)
 	tree.doPreOrder(node.left, visitor)
-	tree.doPreOrder(node.right, visitor)
+	tree.doPreOrder(node.right(), visitor)
 }
 
 func (tree *Tree) doInOrder(node *_Node, visitor Visitor) {
@@ -155,7 +321,7 @@ func (tree *Tree) doInOrder(node *_Node, visitor Visitor) {
 	}
 	tree.doInOrder(node.left, visitor)
 	visitor(node.value)
-	tree.doInOrder(node.right, visitor)
+	tree.doInOrder(node.right(), visitor)
 }
 
 func (tree *Tree) doPostOrder(node *_Node, visitor Visitor) {
@@ -163,7 +329,7 @@ func (tree *Tree) doPostOrder(node *_Node, visitor Visitor) {
 		return
 	}
 	tree.doPostOrder(node.left, visitor)
-	tree.doPostOrder(node.right, visitor)
+	tree.doPostOrder(node.right(), visitor)
 	visitor(node.value)
 }
 
@@ -171,18 +337,16 @@ func (tree *Tree) doLevelOrder(visitor Visitor) {
 	if tree.root == nil {
 		return
 	}
-	queue := list.New()
-	queue.PushBack(tree.root)
-	for queue.Len() > 0 {
-		element := queue.Front()
-		node := (*_Node)(element.Value.(*_Node))
-		queue.Remove(element)
+	var queue _Ring[*_Node]
+	queue.push(tree.root)
+	for queue.len() > 0 {
+		node := queue.pop()
 		visitor(node.value)
 		if node.left != nil {
-			queue.PushBack(node.left)
+			queue.push(node.left)
 		}
-		if node.right != nil {
-			queue.PushBack(node.right)
+		if node.right() != nil {
+			queue.push(node.right())
 		}
 	}
 }
@@ -191,101 +355,420 @@ func (tree *Tree) doLevelOrder(visitor Visitor) {
 // Average case time-complexity: O(depth)
 // Worst case time-complexity: O(size)
 func (tree *Tree) Exists(value interface{}) bool {
-	tree.mutex.RLock()
+	defer tree.recoverPanic()
+	if tree.slowLog != nil {
+		defer tree.observe("Exists", time.Now())
+	}
+	found, _ := tree.exists(value, true)
+	return found
+}
+
+// exists implements Exists without recovering panics
+// Unless wait is set, it fails with ErrWouldBlock instead of waiting for the lock.
+func (tree *Tree) exists(value interface{}, wait bool) (found bool, err error) {
+	tree.counters.countLookup()
+	if tree.counters != nil {
+		defer func() {
+			if !found && err == nil {
+				tree.counters.countMiss()
+			}
+		}()
+	}
+	if tree.checkType(value) != nil {
+		return false, nil
+	}
+	if tree.snapshot != nil {
+		if frozen := tree.snapshot.frozen.Load(); frozen != nil {
+			return frozen.Exists(value), nil
+		}
+	}
+	if tree.balancing == Splay {
+		return tree.splayExists(value, wait)
+	}
+	if err := tree.acquireRead("Exists", wait); err != nil {
+		return false, err
+	}
 	defer tree.mutex.RUnlock()
-	return tree.doExists(tree.root, value)
+	if tree.buffer.contains(tree, value) {
+		return true, nil
+	}
+	if !tree.bloom.mayContain(value) {
+		return false, nil
+	}
+	tree.snapshot.read(tree)
+	return tree.find(value) != nil, nil
 }
 
-func (tree *Tree) doExists(node *_Node, value interface{}) bool {
-	if node == nil {
-		return false
+// find returns the topmost node holding a value equal to value, or nil
+// It is the hot path of every lookup, so it loops instead of recursing and
+// makes a single three-way comparison per node.
+func (tree *Tree) find(value interface{}) *_Node {
+	node := tree.root
+	if tree.keyCache != nil {
+		pre := tree.keyCache(value)
+		for node != nil {
+			switch order := tree.order(value, pre, node); {
+			case order < 0:
+				node = node.left
+			case order > 0:
+				node = node.right()
+			default:
+				return node
+			}
+		}
+		return nil
 	}
-	switch {
-	case tree.smaller(value, node.value):
-		return tree.doExists(node.left, value)
-	case tree.larger(value, node.value):
-		return tree.doExists(node.right, value)
+	compare := tree.compare
+	for node != nil {
+		switch order := compare(value, node.value); {
+		case order < 0:
+			node = node.left
+		case order > 0:
+			node = node.right()
+		default:
+			return node
+		}
 	}
-	return true
+	return nil
 }
 
 // Insert adds value to the tree if it doesn't already exist
 // Returns true if the value was inserted, false otherwise.
+// Trees created with WithDuplicates always insert the value.
 // Average case time-complexity: O(depth)
 // Worst case time-complexity: O(size)
 func (tree *Tree) Insert(value interface{}) bool {
-	tree.mutex.Lock()
+	defer tree.recoverPanic()
+	if tree.slowLog != nil {
+		defer tree.observe("Insert", time.Now())
+	}
+	inserted, _ := tree.insertValue(value, true)
+	return inserted
+}
+
+// insertValue implements Insert without recovering panics
+// Unless wait is set, it fails with ErrWouldBlock instead of waiting for the lock.
+func (tree *Tree) insertValue(value interface{}, wait bool) (bool, error) {
+	tree.counters.countInsert()
+	if tree.checkType(value) != nil {
+		return false, nil
+	}
+	value = tree.own(value)
+	if err := tree.acquire("Insert", wait); err != nil {
+		return false, err
+	}
 	defer tree.mutex.Unlock()
-	if tree.root == nil {
-		tree.root = new_Node(value)
-		tree.size++
-		return true
+	if tree.buffer != nil {
+		if !tree.bufferInsert(value) {
+			return false, nil
+		}
+	} else if !tree.insert(value) {
+		return false, nil
+	}
+	tree.wal.log(_WALInsert, value)
+	tree.hooks.fireInsert(value)
+	return true, nil
+}
+
+// insert adds value to the tree without locking or logging
+// Unbalanced and LLRB trees compare before they modify, so a panicking
+// comparator leaves them intact. Splay trees compare again while moving the
+// new value to the root; a panic there leaves a valid tree holding value.
+func (tree *Tree) insert(value interface{}) bool {
+	tree.assertWriteLocked()
+	var inserted bool
+	var descent _Descent
+	depth := 0
+	pre := tree.preKey(value)
+	switch tree.balancing {
+	case LLRB:
+		tree.root, inserted = tree.llrbInsert(tree.root, value, pre, nil, &descent)
+		tree.root.red = false
+	default:
+		tree.root, inserted = tree.doInsert(tree.root, value, pre, nil, &descent)
+		depth = descent.depth + 1
 	}
-	if tree.doInsert(tree.root, value) {
+	if inserted {
+		tree.orphanRoot()
 		tree.size++
-		return true
+		tree.version++
+		tree.snapshot.invalidate()
+		// Equal values are inserted to the right, so they become the maximum
+		if !descent.right {
+			tree.minimum = value
+		}
+		if !descent.left {
+			tree.maximum = value
+		}
+		if tree.bloom.full() {
+			tree.rebuildBloom()
+		}
+	}
+	if inserted && tree.unbalanced(depth) {
+		tree.rebalance()
+	}
+	if tree.balancing == Splay {
+		tree.splayTo(value, pre)
 	}
-	return false
+	if inserted {
+		tree.verify()
+	}
+	return inserted
 }
 
-func (tree *Tree) doInsert(node *_Node, value interface{}) bool {
+// _Descent records the path an insert or delete took on its way down
+// A descent that never turned right ended at the smallest value and one
+// that never turned left at the largest, so the cached extremes are kept
+// up to date without comparing against them.
+type _Descent struct {
+	depth int // number of nodes passed
+	left  bool
+	right bool
+}
+
+// doInsert adds value to the subtree rooted at node and returns the new subtree root
+// pre is the pre-key of value, see preKey. next is the in-order successor of
+// the subtree, which a new leaf may be threaded to. descent records the path.
+func (tree *Tree) doInsert(node *_Node, value interface{}, pre uint64, next *_Node, descent *_Descent) (*_Node, bool) {
 	if node == nil {
-		return false
+		return tree.newNode(value, next), true
+	}
+	var inserted bool
+	descent.depth++
+	switch order := tree.order(value, pre, node); {
+	case order < 0:
+		descent.left = true
+		node.left, inserted = tree.doInsert(node.left, value, pre, node, descent)
+	case order > 0, tree.duplicates:
+		// Duplicates go right so that equal values stay in insertion order
+		descent.right = true
+		var right *_Node
+		right, inserted = tree.doInsert(node.right(), value, pre, next, descent)
+		tree.setRight(node, right, next)
+	}
+	if inserted {
+		tree.update(node)
 	}
+	return node, inserted
+}
+
+// newNode creates a leaf node holding value, threaded to next if the tree has threads
+func (tree *Tree) newNode(value interface{}, next *_Node) *_Node {
+	node := tree.allocNode(value)
+	tree.setRight(node, nil, next)
+	tree.update(node)
+	return node
+}
+
+// allocNode creates a node holding value using the allocator of the tree
+// Allocators only hand out plain nodes, so trees with fat nodes allocate
+// them on their own.
+func (tree *Tree) allocNode(value interface{}) *_Node {
+	tree.bloom.add(value)
+	var node *_Node
 	switch {
-	case tree.smaller(value, node.value):
-		if node.left == nil {
-			node.left = new_Node(value)
-			return true
-		} else {
-			return tree.doInsert(node.left, value)
+	case tree.fatNodes():
+		node = &new(_FatNode)._Node
+	case tree.allocator != nil:
+		node = (*_Node)(tree.allocator.Allocate())
+	default:
+		node = new_Node(value)
+	}
+	tree.setValue(node, value)
+	node.size = 1
+	return node
+}
+
+// freeNode hands a node that was unlinked from the tree back to the allocator
+func (tree *Tree) freeNode(node *_Node) {
+	if tree.allocator != nil && !tree.fatNodes() {
+		tree.allocator.Free((*Cell)(node))
+	}
+}
+
+// update recomputes the bookkeeping of node from its children
+// It has to be called bottom-up on every node whose subtree changed.
+func (tree *Tree) update(node *_Node) {
+	node.size = 1 + sizeOf(node.left) + sizeOf(node.right())
+	if tree.parents {
+		tree.adopt(node)
+	}
+	if tree.augment != nil {
+		fat(node).aug = tree.augment(node.value, augOf(node.left), augOf(node.right()))
+	}
+	if tree.merkle != nil {
+		fat(node).digest = tree.valueDigest(node.value) + digestOf(node.left) + digestOf(node.right())
+	}
+}
+
+// Delete removes value from the tree if it exists
+// Returns true if the value was deleted, false otherwise.
+// Trees created with WithDuplicates remove a single occurrence.
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (tree *Tree) Delete(value interface{}) bool {
+	defer tree.recoverPanic()
+	deleted, _ := tree.deleteValue(value)
+	return deleted
+}
+
+// deleteValue implements Delete without recovering panics
+func (tree *Tree) deleteValue(value interface{}) (bool, error) {
+	tree.counters.countDelete()
+	if tree.checkType(value) != nil {
+		return false, nil
+	}
+	if err := tree.acquire("Delete", true); err != nil {
+		return false, err
+	}
+	defer tree.mutex.Unlock()
+	tree.flush()
+	if !tree.delete(value) {
+		return false, nil
+	}
+	tree.wal.log(_WALDelete, value)
+	tree.hooks.fireDelete(value)
+	return true, nil
+}
+
+// delete removes value from the tree without locking or logging
+// Unbalanced and LLRB trees compare before they modify, as LLRB trees
+// locate value by rank first, so a panicking comparator leaves them intact.
+// Splay trees compare again while moving the neighbour to the root; a panic
+// there leaves a valid tree without value.
+func (tree *Tree) delete(value interface{}) bool {
+	tree.assertWriteLocked()
+	var deleted bool
+	var descent _Descent
+	pre := tree.preKey(value)
+	switch tree.balancing {
+	case LLRB:
+		tree.root, deleted = tree.llrbDeleteRoot(tree.root, value, pre, &descent)
+	default:
+		tree.root, deleted = tree.doDelete(tree.root, value, pre, nil, &descent)
+	}
+	if deleted {
+		tree.orphanRoot()
+		tree.size--
+		tree.version++
+		tree.snapshot.invalidate()
+		if !descent.left || !descent.right {
+			tree.refreshExtremes()
 		}
-	case tree.larger(value, node.value):
-		if node.right == nil {
-			node.right = new_Node(value)
-			return true
-		} else {
-			return tree.doInsert(node.right, value)
+		if tree.balancing == Splay {
+			tree.splayTo(value, pre)
 		}
+		tree.verify()
+	}
+	return deleted
+}
+
+// doDelete removes value from the subtree rooted at node and returns the new subtree root
+// pre, next and descent are as in doInsert.
+func (tree *Tree) doDelete(node *_Node, value interface{}, pre uint64, next *_Node, descent *_Descent) (*_Node, bool) {
+	if node == nil {
+		return nil, false
+	}
+	var deleted bool
+	switch order := tree.deleteOrder(value, pre, node); {
+	case order < 0:
+		descent.left = true
+		node.left, deleted = tree.doDelete(node.left, value, pre, node, descent)
+	case order > 0:
+		descent.right = true
+		var right *_Node
+		right, deleted = tree.doDelete(node.right(), value, pre, next, descent)
+		tree.setRight(node, right, next)
+	default:
+		switch {
+		case node.left == nil:
+			right := node.right()
+			tree.freeNode(node)
+			return right, true
+		case node.right() == nil:
+			// The predecessor was threaded to node
+			left := node.left
+			tree.setRight(rightmost(left), nil, next)
+			tree.freeNode(node)
+			return left, true
+		}
+		// Replace the value with its in-order successor and remove that instead
+		var right *_Node
+		var successor interface{}
+		right, successor = tree.deleteMinimum(node.right())
+		tree.setRight(node, right, next)
+		tree.setValue(node, successor)
+		deleted = true
+	}
+	if deleted {
+		tree.update(node)
+	}
+	return node, deleted
+}
+
+// deleteMinimum removes the smallest node of a non-empty subtree
+// It returns the new subtree root and the value of the removed node.
+func (tree *Tree) deleteMinimum(node *_Node) (*_Node, interface{}) {
+	if node.left == nil {
+		right, value := node.right(), node.value
+		tree.freeNode(node)
+		return right, value
 	}
-	return false
+	var value interface{}
+	node.left, value = tree.deleteMinimum(node.left)
+	tree.update(node)
+	return node, value
 }
 
 // Minimum returns the smallest value in the tree
-// Average case time-complexity: O(depth)
-// Worst case time-complexity: O(size)
+// Time-complexity: O(1)
 func (tree *Tree) Minimum() interface{} {
-	tree.mutex.RLock()
+	tree.rlock()
 	defer tree.mutex.RUnlock()
-	if tree.root == nil {
-		return nil
-	}
-	node := tree.root
+	return tree.minimum
+}
+
+// Maximum returns the largest value in the tree
+// Time-complexity: O(1)
+func (tree *Tree) Maximum() interface{} {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	return tree.maximum
+}
+
+// leftmost returns the node holding the smallest value of a non-empty subtree
+func leftmost(node *_Node) *_Node {
 	for node.left != nil {
 		node = node.left
 	}
-	return node.value
+	return node
 }
 
-// Maximum returns the largest value in the tree
+// rightmost returns the node holding the largest value of a non-empty subtree
+func rightmost(node *_Node) *_Node {
+	for node.right() != nil {
+		node = node.right()
+	}
+	return node
+}
+
+// refreshExtremes recomputes the cached minimum and maximum from the tree
 // Average case time-complexity: O(depth)
 // Worst case time-complexity: O(size)
-func (tree *Tree) Maximum() interface{} {
-	tree.mutex.RLock()
-	defer tree.mutex.RUnlock()
+func (tree *Tree) refreshExtremes() {
 	if tree.root == nil {
-		return nil
-	}
-	node := tree.root
-	for node.right != nil {
-		node = node.right
+		tree.minimum, tree.maximum = nil, nil
+		return
 	}
-	return node.value
+	tree.minimum = leftmost(tree.root).value
+	tree.maximum = rightmost(tree.root).value
 }
 
 // Depth returns the depth of the tree
 // Time-complexity: O(size)
 func (tree *Tree) Depth() int {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
 	return tree.doDepth(tree.root)
 }
 
@@ -294,7 +777,7 @@ func (tree *Tree) doDepth(node *_Node) int {
 		return 0
 	}
 	left := tree.doDepth(node.left)
-	right := tree.doDepth(node.right)
+	right := tree.doDepth(node.right())
 	var depth int
 	if left > right {
 		depth = left + 1
@@ -303,3 +786,45 @@ func (tree *Tree) doDepth(node *_Node) int {
 	}
 	return depth
 }
+
+// values returns all values of the tree in sorted order
+func (tree *Tree) values() []interface{} {
+	tree.assertLocked()
+	values := make([]interface{}, 0, tree.size)
+	tree.doInOrder(tree.root, func(value interface{}) {
+		values = append(values, value)
+	})
+	return values
+}
+
+// load replaces the contents of the tree with sorted, distinct values
+// Time-complexity: O(size)
+func (tree *Tree) load(values []interface{}) {
+	tree.bloom.reset(len(values))
+	switch tree.balancing {
+	case LLRB:
+		tree.root = tree.llrbBuild(values)
+	default:
+		tree.root = tree.buildBalanced(values, nil)
+	}
+	tree.orphanRoot()
+	tree.size = len(values)
+	tree.version++
+	tree.snapshot.invalidate()
+	tree.refreshExtremes()
+	tree.verify()
+}
+
+// buildBalanced creates a balanced subtree from sorted values
+// next is the in-order successor of the subtree, see doInsert.
+func (tree *Tree) buildBalanced(values []interface{}, next *_Node) *_Node {
+	if len(values) == 0 {
+		return nil
+	}
+	mid := len(values) / 2
+	node := tree.allocNode(values[mid])
+	node.left = tree.buildBalanced(values[:mid], node)
+	tree.setRight(node, tree.buildBalanced(values[mid+1:], next), next)
+	tree.update(node)
+	return node
+}
@@ -29,6 +29,15 @@ func RandomTree(count int, max int) *Tree {
 	return tree
 }
 
+// Values returns the values of a tree in sorted order
+func Values(tree *Tree) []interface{} {
+	var values []interface{}
+	tree.Traverse(InOrder, func(value interface{}) {
+		values = append(values, value)
+	})
+	return values
+}
+
 // CompleteTree generates a complete binary search tree
 // using sequential integers
 func CompleteTree(count int) *Tree {
@@ -59,6 +68,33 @@ func TestTree_MinMax(t *testing.T) {
 	}
 }
 
+func TestTree_MinMaxCached(t *testing.T) {
+	tree := EmptyTree()
+	present := make(map[int]bool)
+	for i := 0; i < 5000; i++ {
+		value := rand.Intn(100)
+		if rand.Intn(2) == 0 {
+			tree.Insert(value)
+			present[value] = true
+		} else {
+			tree.Delete(value)
+			delete(present, value)
+		}
+		var min, max interface{}
+		for v := 0; v < 100; v++ {
+			if present[v] {
+				if min == nil {
+					min = v
+				}
+				max = v
+			}
+		}
+		if min != tree.Minimum() || max != tree.Maximum() {
+			t.Fatalf("MinMax: {Expected: %v,%v | Actual: %v,%v}", min, max, tree.Minimum(), tree.Maximum())
+		}
+	}
+}
+
 func TestTree_Depth(t *testing.T) {
 	for i := 0; i <= 1024; i++ {
 		tree := CompleteTree(i)
@@ -72,6 +108,26 @@ func TestTree_Depth(t *testing.T) {
 	}
 }
 
+func TestTree_Delete(t *testing.T) {
+	tree := CompleteTree(100)
+	for i := 1; i <= 100; i += 2 {
+		if !tree.Delete(i) {
+			t.Errorf("Delete(%d): {Expected: true | Actual: false}", i)
+		}
+	}
+	if tree.Delete(1) {
+		t.Errorf("Delete(1) again: {Expected: false | Actual: true}")
+	}
+	if expected := 50; expected != tree.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+	}
+	for i := 1; i <= 100; i++ {
+		if expected := i%2 == 0; expected != tree.Exists(i) {
+			t.Errorf("Exists(%d): {Expected: %t | Actual: %t}", i, expected, !expected)
+		}
+	}
+}
+
 // Make concurrent goroutines insert different ranges into the tree
 func TestTree_InsertParallel(t *testing.T) {
 	numroutines := runtime.NumCPU() * 2
@@ -142,6 +198,36 @@ func ExampleTree_Insert() {
 	// true
 }
 
+// Version is a Comparable used to test NewComparable
+type Version struct {
+	Major, Minor int
+}
+
+func (version Version) CompareTo(other interface{}) int {
+	that := other.(Version)
+	if version.Major != that.Major {
+		return version.Major - that.Major
+	}
+	return version.Minor - that.Minor
+}
+
+// Insert values implementing Comparable without passing comparators
+func ExampleNewComparable() {
+	tree := NewComparable()
+	tree.Insert(Version{1, 10})
+	tree.Insert(Version{1, 2})
+	tree.Insert(Version{0, 9})
+	tree.Insert(Version{1, 2})
+	fmt.Println(tree.Size())
+	tree.Traverse(InOrder, func(value interface{}) {
+		fmt.Printf("%v,", value)
+	})
+	fmt.Printf("\n")
+	// Output:
+	// 3
+	// {0 9},{1 2},{1 10},
+}
+
 // Benchmark insert performance on a single core
 func BenchmarkTreeInsert(b *testing.B) {
 	b.StopTimer()
@@ -0,0 +1,180 @@
+// Package bstreebench provides workloads for benchmarking bstree trees of any value type.
+//
+// A workload draws keys, which are ints in [0, Keys), from a distribution
+// and turns each key into a value with a function supplied by the user,
+// so the same workloads can compare comparators and value types on every
+// balancing backend.
+package bstreebench
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	"github.com/lazybeaver/go-bstree"
+)
+
+// Distribution creates a generator of keys in [0, n) drawing randomness from rng
+type Distribution func(rng *rand.Rand, n int) func() int
+
+// Uniform draws every key with the same probability
+func Uniform() Distribution {
+	return func(rng *rand.Rand, n int) func() int {
+		return func() int {
+			return rng.Intn(n)
+		}
+	}
+}
+
+// Sequential counts up through the keys, starting over after the last one
+func Sequential() Distribution {
+	return func(rng *rand.Rand, n int) func() int {
+		key := -1
+		return func() int {
+			key = (key + 1) % n
+			return key
+		}
+	}
+}
+
+// Zipfian draws key k with a probability proportional to 1/(k+1)^s
+// s has to be larger than 1; the larger s, the more skewed the keys.
+func Zipfian(s float64) Distribution {
+	return func(rng *rand.Rand, n int) func() int {
+		zipf := rand.NewZipf(rng, s, 1, uint64(n-1))
+		return func() int {
+			return int(zipf.Uint64())
+		}
+	}
+}
+
+// Workload describes a mix of operations on a tree
+// Before a run, the tree is loaded with every other key in random order,
+// so about half of all lookups find a value.
+type Workload struct {
+	Name         string
+	Keys         int          // number of distinct keys
+	Reads        int          // percentage of operations that are lookups, the others insert or delete
+	Distribution Distribution // distribution of the keys of all operations
+}
+
+// Workloads returns a standard set of workloads over n keys
+func Workloads(n int) []Workload {
+	return []Workload{
+		{Name: "Uniform/ReadOnly", Keys: n, Reads: 100, Distribution: Uniform()},
+		{Name: "Uniform/ReadMostly", Keys: n, Reads: 95, Distribution: Uniform()},
+		{Name: "Uniform/ReadWrite", Keys: n, Reads: 50, Distribution: Uniform()},
+		{Name: "Sequential/WriteMostly", Keys: n, Reads: 10, Distribution: Sequential()},
+		{Name: "Zipfian/ReadMostly", Keys: n, Reads: 95, Distribution: Zipfian(1.1)},
+	}
+}
+
+// Backend names an option selecting how a tree is balanced
+type Backend struct {
+	Name   string
+	Option bstree.Option
+}
+
+// Backends lists the balancing backends of bstree
+var Backends = []Backend{
+	{Name: "Unbalanced", Option: bstree.WithBalancing(bstree.Unbalanced)},
+	{Name: "LLRB", Option: bstree.WithBalancing(bstree.LLRB)},
+	{Name: "Splay", Option: bstree.WithBalancing(bstree.Splay)},
+}
+
+// Factory creates an empty tree with the given options added to its own
+// It is where the comparators of the values under test are chosen.
+type Factory func(options ...bstree.Option) *bstree.Tree
+
+// Result is the outcome of running a workload on a backend
+type Result struct {
+	Backend  string
+	Workload string
+	Ops      int
+	Elapsed  time.Duration
+	Depth    int // depth of the tree after the run
+}
+
+// NsPerOp returns the average duration of an operation in nanoseconds
+func (result Result) NsPerOp() float64 {
+	if result.Ops == 0 {
+		return 0
+	}
+	return float64(result.Elapsed.Nanoseconds()) / float64(result.Ops)
+}
+
+// Measure runs ops operations of workload on a loaded tree of backend
+// value converts keys to the values stored in the tree; its cost is part
+// of the measured time. The same seed always produces the same operations.
+func Measure(factory Factory, value func(key int) interface{}, backend Backend, workload Workload, ops int, seed int64) Result {
+	rng := rand.New(rand.NewSource(seed))
+	tree := load(factory, value, backend, workload, rng)
+	run := runner(tree, value, workload, rng)
+	start := time.Now()
+	run(ops)
+	return Result{
+		Backend:  backend.Name,
+		Workload: workload.Name,
+		Ops:      ops,
+		Elapsed:  time.Since(start),
+		Depth:    tree.Depth(),
+	}
+}
+
+// Benchmark runs each workload on each backend as a sub-benchmark named Backend/Workload
+// Results of different value types or revisions can be compared with benchstat.
+func Benchmark(b *testing.B, factory Factory, value func(key int) interface{}, workloads []Workload) {
+	for _, backend := range Backends {
+		for _, workload := range workloads {
+			b.Run(fmt.Sprintf("%s/%s", backend.Name, workload.Name), func(b *testing.B) {
+				rng := rand.New(rand.NewSource(1))
+				tree := load(factory, value, backend, workload, rng)
+				run := runner(tree, value, workload, rng)
+				b.ResetTimer()
+				run(b.N)
+			})
+		}
+	}
+}
+
+// Report writes results to w as a table
+func Report(w io.Writer, results []Result) error {
+	table := tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(table, "backend\tworkload\tops\tns/op\tdepth\t")
+	for _, result := range results {
+		fmt.Fprintf(table, "%s\t%s\t%d\t%.1f\t%d\t\n", result.Backend, result.Workload, result.Ops, result.NsPerOp(), result.Depth)
+	}
+	return table.Flush()
+}
+
+// load creates a tree of backend holding every other key of workload in random order
+func load(factory Factory, value func(key int) interface{}, backend Backend, workload Workload, rng *rand.Rand) *bstree.Tree {
+	tree := factory(backend.Option)
+	for _, key := range rng.Perm(workload.Keys) {
+		if key%2 == 0 {
+			tree.Insert(value(key))
+		}
+	}
+	return tree
+}
+
+// runner returns a function performing the next n operations of workload on tree
+func runner(tree *bstree.Tree, value func(key int) interface{}, workload Workload, rng *rand.Rand) func(n int) {
+	next := workload.Distribution(rng, workload.Keys)
+	return func(n int) {
+		for i := 0; i < n; i++ {
+			key := next()
+			switch op := rng.Intn(100); {
+			case op < workload.Reads:
+				tree.Exists(value(key))
+			case op%2 == 0:
+				tree.Insert(value(key))
+			default:
+				tree.Delete(value(key))
+			}
+		}
+	}
+}
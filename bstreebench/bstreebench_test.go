@@ -0,0 +1,50 @@
+package bstreebench
+
+import (
+	"bytes"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/lazybeaver/go-bstree"
+)
+
+func newStringTree(options ...bstree.Option) *bstree.Tree {
+	return bstree.New(bstree.StringFoldSmaller, bstree.StringFoldLarger, options...)
+}
+
+func TestMeasure(t *testing.T) {
+	var results []Result
+	for _, backend := range Backends {
+		for _, workload := range Workloads(1000) {
+			result := Measure(newStringTree, func(key int) interface{} { return strconv.Itoa(key) }, backend, workload, 2000, 1)
+			if result.Ops != 2000 || result.Depth == 0 {
+				t.Errorf("Measure(%s, %s): {Expected: 2000 ops on a loaded tree | Actual: %+v}", backend.Name, workload.Name, result)
+			}
+			results = append(results, result)
+		}
+	}
+	var buffer bytes.Buffer
+	if err := Report(&buffer, results); err != nil {
+		t.Fatalf("Report: {Expected: <nil> | Actual: %v}", err)
+	}
+	if lines := strings.Count(buffer.String(), "\n"); lines != len(results)+1 {
+		t.Errorf("Report lines: {Expected: %d | Actual: %d}", len(results)+1, lines)
+	}
+}
+
+func TestZipfian(t *testing.T) {
+	next := Zipfian(1.5)(rand.New(rand.NewSource(1)), 100)
+	counts := make([]int, 100)
+	for i := 0; i < 10000; i++ {
+		counts[next()]++
+	}
+	if counts[0] < counts[1] || counts[1] < counts[10] || counts[10] < counts[99] {
+		t.Errorf("Counts: {Expected: decreasing | Actual: %d %d %d %d}", counts[0], counts[1], counts[10], counts[99])
+	}
+}
+
+func BenchmarkStrings(b *testing.B) {
+	Benchmark(b, newStringTree, func(key int) interface{} { return strconv.Itoa(key) }, Workloads(4096))
+}
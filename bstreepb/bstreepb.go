@@ -0,0 +1,113 @@
+// Package bstreepb serializes bstree trees as protocol buffers.
+//
+// The messages follow the schema in snapshot.proto, so snapshots can be
+// consumed from any language with protobuf support. The wire format is
+// written and parsed directly, without depending on a protobuf runtime.
+package bstreepb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/lazybeaver/go-bstree"
+)
+
+// Field numbers and wire types of the Snapshot message
+const (
+	valuesField = 1
+	sizeField   = 2
+
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// ErrMalformed is returned when a snapshot cannot be parsed
+var ErrMalformed = errors.New("bstreepb: malformed snapshot")
+
+// MarshalProto encodes the tree as a Snapshot message
+// encode converts a single value to bytes.
+// Time-complexity: O(size)
+func MarshalProto(tree *bstree.Tree, encode func(value interface{}) ([]byte, error)) ([]byte, error) {
+	var data []byte
+	var err error
+	size := 0
+	tree.Traverse(bstree.PreOrder, func(value interface{}) {
+		if err != nil {
+			return
+		}
+		var encoded []byte
+		if encoded, err = encode(value); err != nil {
+			return
+		}
+		data = binary.AppendUvarint(data, valuesField<<3|wireBytes)
+		data = binary.AppendUvarint(data, uint64(len(encoded)))
+		data = append(data, encoded...)
+		size++
+	})
+	if err != nil {
+		return nil, err
+	}
+	data = binary.AppendUvarint(data, sizeField<<3|wireVarint)
+	data = binary.AppendUvarint(data, uint64(size))
+	return data, nil
+}
+
+// UnmarshalProto creates a tree with the contents and shape of a Snapshot message
+// decode converts the bytes of a single value back to the value.
+// Average case time-complexity: O(size * depth)
+func UnmarshalProto(data []byte, decode func(data []byte) (interface{}, error), smaller bstree.Smaller, larger bstree.Larger, options ...bstree.Option) (*bstree.Tree, error) {
+	tree := bstree.New(smaller, larger, options...)
+	var size uint64
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, ErrMalformed
+		}
+		data = data[n:]
+		field, wire := key>>3, key&7
+		switch wire {
+		case wireVarint:
+			number, n := binary.Uvarint(data)
+			if n <= 0 {
+				return nil, ErrMalformed
+			}
+			data = data[n:]
+			if field == sizeField {
+				size = number
+			}
+		case wireBytes:
+			length, n := binary.Uvarint(data)
+			if n <= 0 || uint64(len(data)-n) < length {
+				return nil, ErrMalformed
+			}
+			payload := data[n : n+int(length)]
+			data = data[n+int(length):]
+			if field == valuesField {
+				value, err := decode(payload)
+				if err != nil {
+					return nil, err
+				}
+				tree.Insert(value)
+			}
+		case wireFixed64:
+			if len(data) < 8 {
+				return nil, ErrMalformed
+			}
+			data = data[8:]
+		case wireFixed32:
+			if len(data) < 4 {
+				return nil, ErrMalformed
+			}
+			data = data[4:]
+		default:
+			return nil, ErrMalformed
+		}
+	}
+	if uint64(tree.Size()) != size {
+		return nil, fmt.Errorf("%w: expected %d values, got %d", ErrMalformed, size, tree.Size())
+	}
+	return tree, nil
+}
@@ -0,0 +1,64 @@
+package bstreepb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/lazybeaver/go-bstree"
+)
+
+func encodeInt(value interface{}) ([]byte, error) {
+	return binary.AppendVarint(nil, int64(value.(int))), nil
+}
+
+func decodeInt(data []byte) (interface{}, error) {
+	value, n := binary.Varint(data)
+	if n <= 0 {
+		return nil, errors.New("bad int")
+	}
+	return int(value), nil
+}
+
+func preOrder(tree *bstree.Tree) string {
+	var values []interface{}
+	tree.Traverse(bstree.PreOrder, func(value interface{}) {
+		values = append(values, value)
+	})
+	return fmt.Sprint(values)
+}
+
+func TestProto_RoundTrip(t *testing.T) {
+	tree := bstree.New(bstree.IntSmaller, bstree.IntLarger)
+	for i := 0; i < 500; i++ {
+		tree.Insert(rand.Intn(1000) - 500)
+	}
+	data, err := MarshalProto(tree, encodeInt)
+	if err != nil {
+		t.Fatalf("MarshalProto: {Expected: nil | Actual: %v}", err)
+	}
+	loaded, err := UnmarshalProto(data, decodeInt, bstree.IntSmaller, bstree.IntLarger)
+	if err != nil {
+		t.Fatalf("UnmarshalProto: {Expected: nil | Actual: %v}", err)
+	}
+	if tree.Size() != loaded.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", tree.Size(), loaded.Size())
+	}
+	if preOrder(tree) != preOrder(loaded) {
+		t.Errorf("PreOrder: {Expected: %s | Actual: %s}", preOrder(tree), preOrder(loaded))
+	}
+}
+
+func TestProto_Malformed(t *testing.T) {
+	tree := bstree.New(bstree.IntSmaller, bstree.IntLarger)
+	tree.Insert(1)
+	tree.Insert(2)
+	data, _ := MarshalProto(tree, encodeInt)
+	for length := 1; length < len(data); length++ {
+		if _, err := UnmarshalProto(data[:length], decodeInt, bstree.IntSmaller, bstree.IntLarger); err == nil {
+			t.Errorf("UnmarshalProto(%d bytes): {Expected: error | Actual: nil}", length)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Package bstreetest provides deterministic builders of bstree trees for tests and benchmarks,
+// and a conformance suite for alternative tree implementations.
+//
+// All trees hold ints ordered by bstree.IntSmaller and bstree.IntLarger.
+package bstreetest
+
+import (
+	"math/rand"
+
+	"github.com/lazybeaver/go-bstree"
+)
+
+// RandomTree builds a tree of count distinct random values in [0, max)
+// The same seed always produces the same tree. It panics if count > max.
+// Average case time-complexity: O(count * log(count))
+func RandomTree(seed int64, count int, max int, options ...bstree.Option) *bstree.Tree {
+	if count > max {
+		panic("bstreetest: cannot generate more distinct values than max")
+	}
+	rng := rand.New(rand.NewSource(seed))
+	tree := bstree.New(bstree.IntSmaller, bstree.IntLarger, options...)
+	for tree.Size() < count {
+		tree.Insert(rng.Intn(max))
+	}
+	return tree
+}
+
+// CompleteTree builds a complete tree holding 1 to n
+// Time-complexity: O(n * log(n))
+func CompleteTree(n int, options ...bstree.Option) *bstree.Tree {
+	tree := bstree.New(bstree.IntSmaller, bstree.IntLarger, options...)
+	insertMiddleFirst(tree, 1, n)
+	return tree
+}
+
+func insertMiddleFirst(tree *bstree.Tree, begin int, end int) {
+	if begin > end {
+		return
+	}
+	mid := (begin + end) / 2
+	tree.Insert(mid)
+	insertMiddleFirst(tree, begin, mid-1)
+	insertMiddleFirst(tree, mid+1, end)
+}
+
+// DegenerateTree builds a tree holding 1 to n as a single chain of right children
+// Unless a balancing option is passed, its depth equals n.
+// Time-complexity: O(n^2) for unbalanced trees
+func DegenerateTree(n int, options ...bstree.Option) *bstree.Tree {
+	tree := bstree.New(bstree.IntSmaller, bstree.IntLarger, options...)
+	for i := 1; i <= n; i++ {
+		tree.Insert(i)
+	}
+	return tree
+}
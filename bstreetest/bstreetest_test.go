@@ -0,0 +1,43 @@
+package bstreetest
+
+import (
+	"testing"
+
+	"github.com/lazybeaver/go-bstree"
+)
+
+func TestRandomTree_Deterministic(t *testing.T) {
+	a := RandomTree(42, 500, 1000)
+	b := RandomTree(42, 500, 1000)
+	if a.Size() != 500 {
+		t.Errorf("Size: {Expected: 500 | Actual: %d}", a.Size())
+	}
+	if added, removed := a.Diff(b); len(added) != 0 || len(removed) != 0 {
+		t.Errorf("Diff: {Expected: [] [] | Actual: %v %v}", added, removed)
+	}
+	if a.PrettyString(bstree.PrintOptions{}) != b.PrettyString(bstree.PrintOptions{}) {
+		t.Errorf("Shape: {Expected: identical | Actual: different}")
+	}
+}
+
+func TestShapes(t *testing.T) {
+	if depth := CompleteTree(1023).Depth(); depth != 10 {
+		t.Errorf("CompleteTree Depth: {Expected: 10 | Actual: %d}", depth)
+	}
+	if depth := DegenerateTree(100).Depth(); depth != 100 {
+		t.Errorf("DegenerateTree Depth: {Expected: 100 | Actual: %d}", depth)
+	}
+	if depth := DegenerateTree(100, bstree.WithBalancing(bstree.LLRB)).Depth(); depth > 14 {
+		t.Errorf("Balanced DegenerateTree Depth: {Expected: <= 14 | Actual: %d}", depth)
+	}
+}
+
+func TestRunConformance(t *testing.T) {
+	for name, balancing := range map[string]bstree.Balancing{"Unbalanced": bstree.Unbalanced, "LLRB": bstree.LLRB, "Splay": bstree.Splay} {
+		t.Run(name, func(t *testing.T) {
+			RunConformance(t, func() bstree.OrderedTree {
+				return bstree.New(bstree.IntSmaller, bstree.IntLarger, bstree.WithBalancing(balancing))
+			})
+		})
+	}
+}
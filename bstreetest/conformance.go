@@ -0,0 +1,142 @@
+package bstreetest
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/lazybeaver/go-bstree"
+)
+
+// Factory creates an empty tree of distinct ints ordered like bstree.IntSmaller and bstree.IntLarger
+type Factory func() bstree.OrderedTree
+
+// RunConformance checks that the trees created by factory behave like bstree trees
+// Each check runs as a subtest on a fresh tree, comparing the tree with a
+// simple model after random operations. The operations are the same on
+// every run, so failures can be reproduced.
+func RunConformance(t *testing.T, factory Factory) {
+	t.Run("Empty", func(t *testing.T) {
+		tree := factory()
+		if tree.Size() != 0 || tree.Minimum() != nil || tree.Maximum() != nil || tree.Exists(1) {
+			t.Errorf("Empty tree: {Expected: 0 <nil> <nil> false | Actual: %d %v %v %t}", tree.Size(), tree.Minimum(), tree.Maximum(), tree.Exists(1))
+		}
+		if tree.Delete(1) {
+			t.Errorf("Delete(1): {Expected: false | Actual: true}")
+		}
+	})
+	t.Run("InsertDistinct", func(t *testing.T) {
+		tree := factory()
+		if !tree.Insert(1) {
+			t.Errorf("Insert(1): {Expected: true | Actual: false}")
+		}
+		if tree.Insert(1) {
+			t.Errorf("Insert(1) again: {Expected: false | Actual: true}")
+		}
+		if tree.Size() != 1 {
+			t.Errorf("Size: {Expected: 1 | Actual: %d}", tree.Size())
+		}
+	})
+	t.Run("RandomOperations", func(t *testing.T) {
+		tree := factory()
+		rng := rand.New(rand.NewSource(1))
+		present := make(map[int]bool)
+		for i := 0; i < 5000; i++ {
+			value := rng.Intn(500)
+			if rng.Intn(3) == 0 {
+				if expected := present[value]; expected != tree.Delete(value) {
+					t.Fatalf("Operation %d, Delete(%d): {Expected: %t | Actual: %t}", i, value, expected, !expected)
+				}
+				delete(present, value)
+			} else {
+				if expected := !present[value]; expected != tree.Insert(value) {
+					t.Fatalf("Operation %d, Insert(%d): {Expected: %t | Actual: %t}", i, value, expected, !expected)
+				}
+				present[value] = true
+			}
+			if tree.Size() != len(present) {
+				t.Fatalf("Operation %d, Size: {Expected: %d | Actual: %d}", i, len(present), tree.Size())
+			}
+		}
+		for value := 0; value < 500; value++ {
+			if present[value] != tree.Exists(value) {
+				t.Errorf("Exists(%d): {Expected: %t | Actual: %t}", value, present[value], !present[value])
+			}
+		}
+		checkContents(t, tree, present)
+	})
+	t.Run("SortedInserts", func(t *testing.T) {
+		tree := factory()
+		present := make(map[int]bool)
+		for i := 0; i < 1000; i++ {
+			tree.Insert(i)
+			present[i] = true
+		}
+		for i := 0; i < 1000; i += 3 {
+			tree.Delete(i)
+			delete(present, i)
+		}
+		checkContents(t, tree, present)
+	})
+}
+
+// checkContents compares the values, extremes, ranges and traversals of tree with the model
+func checkContents(t *testing.T, tree bstree.OrderedTree, present map[int]bool) {
+	t.Helper()
+	var expected []int
+	for value := range present {
+		expected = append(expected, value)
+	}
+	sort.Ints(expected)
+	var actual []int
+	tree.Traverse(bstree.InOrder, func(value interface{}) {
+		actual = append(actual, value.(int))
+	})
+	if fmt.Sprint(expected) != fmt.Sprint(actual) {
+		t.Errorf("InOrder: {Expected: %v | Actual: %v}", expected, actual)
+	}
+	if len(expected) > 0 && (tree.Minimum() != expected[0] || tree.Maximum() != expected[len(expected)-1]) {
+		t.Errorf("Extremes: {Expected: %d %d | Actual: %v %v}", expected[0], expected[len(expected)-1], tree.Minimum(), tree.Maximum())
+	}
+	var iterated []int
+	for it := tree.Iterator(); it.Next(); {
+		iterated = append(iterated, it.Value().(int))
+	}
+	if fmt.Sprint(expected) != fmt.Sprint(iterated) {
+		t.Errorf("Iterator: {Expected: %v | Actual: %v}", expected, iterated)
+	}
+	if depth := tree.Depth(); (depth == 0) != (len(expected) == 0) || depth > len(expected) {
+		t.Errorf("Depth: {Expected: between 1 and %d | Actual: %d}", len(expected), depth)
+	}
+	// Bounds between, on and beyond the values
+	for bound := -1; bound <= 2*len(expected)+1; bound++ {
+		below := sort.SearchInts(expected, bound+1)
+		above := sort.SearchInts(expected, bound)
+		if value, ok := tree.MaximumAtMost(bound); ok != (below > 0) || (ok && value != expected[below-1]) {
+			t.Errorf("MaximumAtMost(%d): {Expected: %t | Actual: %v %t}", bound, below > 0, value, ok)
+		}
+		if value, ok := tree.MinimumAtLeast(bound); ok != (above < len(expected)) || (ok && value != expected[above]) {
+			t.Errorf("MinimumAtLeast(%d): {Expected: %t | Actual: %v %t}", bound, above < len(expected), value, ok)
+		}
+		r := bstree.Range{GTE: bound, LT: bound + 10}
+		inRange := sort.SearchInts(expected, bound+10) - above
+		if count := tree.CountRange(r); count != inRange {
+			t.Errorf("CountRange(%+v): {Expected: %d | Actual: %d}", r, inRange, count)
+		}
+		visited := 0
+		tree.TraverseRange(r, func(value interface{}) { visited++ })
+		if visited != inRange {
+			t.Errorf("TraverseRange(%+v): {Expected: %d values | Actual: %d}", r, inRange, visited)
+		}
+	}
+	for _, traversal := range []bstree.Traversal{bstree.PreOrder, bstree.PostOrder, bstree.LevelOrder} {
+		visited := make(map[int]bool)
+		tree.Traverse(traversal, func(value interface{}) {
+			visited[value.(int)] = true
+		})
+		if len(visited) != len(present) {
+			t.Errorf("Traversal %d: {Expected: %d values | Actual: %d}", traversal, len(present), len(visited))
+		}
+	}
+}
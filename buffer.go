@@ -0,0 +1,112 @@
+package bstree
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// _InsertBuffer holds inserted values that have not been merged into the tree yet
+type _InsertBuffer struct {
+	values   []interface{} // sorted, equal values in insertion order
+	capacity int
+}
+
+// WithInsertBuffer makes Insert collect up to n values in a sorted buffer before merging them into the tree
+// Like the memtable of a log-structured merge tree, this turns bursts of
+// inserts into cheap appends to a small sorted slice, followed by a single
+// merge of the whole batch. Insert and Exists consult the buffer directly;
+// all other operations merge pending values first, so they always see
+// every inserted value. Hooks and the write-ahead log are notified when
+// a value enters the buffer.
+func WithInsertBuffer(n int) Option {
+	return func(tree *Tree) {
+		if n > 0 {
+			tree.buffer = &_InsertBuffer{values: make([]interface{}, 0, n), capacity: n}
+		}
+	}
+}
+
+// len returns the number of pending values
+func (buffer *_InsertBuffer) len() int {
+	if buffer == nil {
+		return 0
+	}
+	return len(buffer.values)
+}
+
+// contains checks if a value equal to value is pending
+// Time-complexity: O(log(n))
+func (buffer *_InsertBuffer) contains(tree *Tree, value interface{}) bool {
+	if buffer == nil {
+		return false
+	}
+	i := sort.Search(len(buffer.values), func(i int) bool {
+		return !tree.smaller(buffer.values[i], value)
+	})
+	return i < len(buffer.values) && !tree.larger(buffer.values[i], value)
+}
+
+// bufferInsert adds value to the buffer unless it already exists, merging a full buffer
+// Time-complexity: O(depth + n), plus the merge when the buffer is full
+func (tree *Tree) bufferInsert(value interface{}) bool {
+	tree.assertWriteLocked()
+	buffer := tree.buffer
+	if !tree.duplicates && (tree.find(value) != nil || buffer.contains(tree, value)) {
+		return false
+	}
+	// Insert behind equal values to keep duplicates in insertion order
+	i := sort.Search(len(buffer.values), func(i int) bool {
+		return tree.larger(buffer.values[i], value)
+	})
+	buffer.values = append(buffer.values, nil)
+	copy(buffer.values[i+1:], buffer.values[i:])
+	buffer.values[i] = value
+	tree.version++
+	tree.snapshot.invalidate()
+	if len(buffer.values) == buffer.capacity {
+		tree.flush()
+	}
+	return true
+}
+
+// flush merges the pending values into the tree
+// Small batches are inserted one by one, large ones are merged with the
+// values of the tree and rebuilt, whichever needs fewer steps.
+// Time-complexity: O(min(n * depth, size + n))
+func (tree *Tree) flush() {
+	tree.assertWriteLocked()
+	if tree.buffer.len() == 0 {
+		return
+	}
+	pending := tree.buffer.values
+	if len(pending)*bits.Len(uint(tree.size)) < tree.size {
+		for _, value := range pending {
+			tree.insert(value)
+		}
+	} else {
+		values := tree.values()
+		tree.freeSubtree(tree.root)
+		tree.load(mergeSorted(tree.smaller, tree.larger, values, pending))
+	}
+	clear(pending)
+	tree.buffer.values = pending[:0]
+}
+
+// rlock acquires the read lock of a tree without pending inserts
+func (tree *Tree) rlock() {
+	for {
+		tree.readLock()
+		if tree.buffer.len() == 0 {
+			return
+		}
+		tree.mutex.RUnlock()
+		tree.lock()
+		tree.mutex.Unlock()
+	}
+}
+
+// lock acquires the write lock and merges pending inserts
+func (tree *Tree) lock() {
+	tree.writeLock()
+	tree.flush()
+}
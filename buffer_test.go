@@ -0,0 +1,74 @@
+package bstree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestTree_InsertBuffer(t *testing.T) {
+	for _, n := range []int{1, 7, 64, 1000} {
+		rng := rand.New(rand.NewSource(int64(n)))
+		buffered := New(IntSmaller, IntLarger, WithInsertBuffer(n), WithReadSnapshot())
+		plain := EmptyTree()
+		for i := 0; i < 2000; i++ {
+			value := rng.Intn(500)
+			switch rng.Intn(4) {
+			case 0:
+				if buffered.Delete(value) != plain.Delete(value) {
+					t.Fatalf("Delete(%d) with buffer of %d differs", value, n)
+				}
+			case 1:
+				if buffered.Exists(value) != plain.Exists(value) {
+					t.Fatalf("Exists(%d) with buffer of %d differs", value, n)
+				}
+			default:
+				if buffered.Insert(value) != plain.Insert(value) {
+					t.Fatalf("Insert(%d) with buffer of %d differs", value, n)
+				}
+			}
+			if buffered.Size() != plain.Size() {
+				t.Fatalf("Size with buffer of %d: {Expected: %d | Actual: %d}", n, plain.Size(), buffered.Size())
+			}
+		}
+		added, removed := plain.Diff(buffered)
+		if len(added) != 0 || len(removed) != 0 {
+			t.Errorf("Diff with buffer of %d: {Expected: [] [] | Actual: %v %v}", n, added, removed)
+		}
+		if buffered.Minimum() != plain.Minimum() || buffered.Maximum() != plain.Maximum() {
+			t.Errorf("MinMax with buffer of %d: {Expected: %v,%v | Actual: %v,%v}", n, plain.Minimum(), plain.Maximum(), buffered.Minimum(), buffered.Maximum())
+		}
+		mustCheck(t, buffered)
+	}
+}
+
+func TestTree_InsertBufferDuplicates(t *testing.T) {
+	tree := NewByKey(func(value interface{}) interface{} {
+		return value.(record).key
+	}, IntSmaller, IntLarger, WithDuplicates(), WithInsertBuffer(8))
+	for i := 0; i < 20; i++ {
+		tree.Insert(record{i % 3, fmt.Sprint(i)})
+	}
+	var names []string
+	tree.Traverse(InOrder, func(value interface{}) {
+		if value.(record).key == 1 {
+			names = append(names, value.(record).name)
+		}
+	})
+	if expected := "[1 4 7 10 13 16 19]"; expected != fmt.Sprint(names) {
+		t.Errorf("Order: {Expected: %s | Actual: %v}", expected, names)
+	}
+}
+
+// Buffer bursts of inserts and merge them in batches
+func ExampleWithInsertBuffer() {
+	tree := New(IntSmaller, IntLarger, WithInsertBuffer(100))
+	for i := 0; i < 10; i++ {
+		tree.Insert(i)
+	}
+	fmt.Println(tree.Exists(5), tree.Size())
+	fmt.Println(tree)
+	// Output:
+	// true 10
+	// {size: 10 | depth: 4 | min: 0 | max: 9 | values: [0 1 2 3 4 5 6 7 8 9]}
+}
@@ -0,0 +1,98 @@
+package bstree
+
+import (
+	"sort"
+	"sync"
+)
+
+// BuildFrom creates a balanced tree from the values received on ch
+// The values are split into consecutive runs as they arrive, and each run
+// is sorted by one of workers goroutines. The sorted runs are then merged,
+// dropping duplicates unless the tree has them, and the tree is built in a
+// single pass. Equal values stay in the order they were received in.
+// BuildFrom returns once ch is closed. Values rejected by WithType are dropped.
+// Time-complexity: O(size * log(size) / workers + size * log(workers))
+func BuildFrom(smaller Smaller, larger Larger, ch <-chan interface{}, workers int, options ...Option) *Tree {
+	if workers < 1 {
+		workers = 1
+	}
+	tree := New(smaller, larger, options...)
+	var received []interface{}
+	for value := range ch {
+		if tree.checkType(value) == nil {
+			received = append(received, value)
+		}
+	}
+	buckets := make([][]interface{}, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			bucket := received[i*len(received)/workers : (i+1)*len(received)/workers]
+			for j, value := range bucket {
+				bucket[j] = tree.own(value)
+			}
+			sort.SliceStable(bucket, func(a, b int) bool {
+				return tree.smaller(bucket[a], bucket[b])
+			})
+			buckets[i] = bucket
+		}(i)
+	}
+	wg.Wait()
+
+	// Merge neighbouring buckets pairwise until a single sorted run remains,
+	// so that ties are resolved in favour of the values received first
+	for len(buckets) > 1 {
+		var merged [][]interface{}
+		for i := 0; i < len(buckets); i += 2 {
+			if i+1 == len(buckets) {
+				merged = append(merged, buckets[i])
+				break
+			}
+			merged = append(merged, mergeSorted(tree.smaller, tree.larger, buckets[i], buckets[i+1]))
+		}
+		buckets = merged
+	}
+	values := buckets[0]
+	if !tree.duplicates {
+		values = dedupSorted(tree.larger, values)
+	}
+	tree.mutex.Lock()
+	defer tree.mutex.Unlock()
+	tree.load(values)
+	for _, value := range values {
+		tree.wal.log(_WALInsert, value)
+	}
+	return tree
+}
+
+// mergeSorted merges two sorted slices into a new sorted slice
+func mergeSorted(smaller Smaller, larger Larger, a []interface{}, b []interface{}) []interface{} {
+	merged := make([]interface{}, 0, len(a)+len(b))
+	for len(a) > 0 && len(b) > 0 {
+		if larger(a[0], b[0]) {
+			merged = append(merged, b[0])
+			b = b[1:]
+		} else {
+			merged = append(merged, a[0])
+			a = a[1:]
+		}
+	}
+	merged = append(merged, a...)
+	return append(merged, b...)
+}
+
+// dedupSorted removes consecutive equal values from a sorted slice in place
+func dedupSorted(larger Larger, values []interface{}) []interface{} {
+	if len(values) == 0 {
+		return values
+	}
+	unique := values[:1]
+	for _, value := range values[1:] {
+		if larger(value, unique[len(unique)-1]) {
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
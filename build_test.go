@@ -0,0 +1,102 @@
+package bstree
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestBuildFrom(t *testing.T) {
+	for _, workers := range []int{0, 1, 3, 8} {
+		ch := make(chan interface{})
+		go func() {
+			for i := 0; i < 5000; i++ {
+				ch <- rand.Intn(1000)
+			}
+			for i := 0; i < 1000; i++ {
+				ch <- i
+			}
+			close(ch)
+		}()
+		tree := BuildFrom(IntSmaller, IntLarger, ch, workers)
+		if expected := 1000; expected != tree.Size() {
+			t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+		}
+		if expected := 10; expected != tree.Depth() {
+			t.Errorf("Depth: {Expected: %d | Actual: %d}", expected, tree.Depth())
+		}
+		previous := -1
+		tree.Traverse(InOrder, func(value interface{}) {
+			if value.(int) != previous+1 {
+				t.Errorf("InOrder: {Expected: %d | Actual: %d}", previous+1, value)
+			}
+			previous = value.(int)
+		})
+	}
+}
+
+func TestBuildFromDuplicates(t *testing.T) {
+	smaller := func(value interface{}, other interface{}) bool {
+		return value.(record).key < other.(record).key
+	}
+	larger := func(value interface{}, other interface{}) bool {
+		return value.(record).key > other.(record).key
+	}
+	for _, workers := range []int{1, 3, 8} {
+		ch := make(chan interface{})
+		go func() {
+			for i := 0; i < 1000; i++ {
+				ch <- record{key: rand.Intn(10), name: fmt.Sprintf("%04d", i)}
+			}
+			close(ch)
+		}()
+		tree := BuildFrom(smaller, larger, ch, workers, WithDuplicates())
+		if expected := 1000; expected != tree.Size() {
+			t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+		}
+		mustCheck(t, tree)
+		previous := record{key: -1}
+		tree.Traverse(InOrder, func(value interface{}) {
+			current := value.(record)
+			if previous.key > current.key || (previous.key == current.key && previous.name > current.name) {
+				t.Errorf("Order: {Previous: %v | Current: %v}", previous, current)
+			}
+			previous = current
+		})
+	}
+}
+
+func TestBuildFromNilPolicy(t *testing.T) {
+	for _, policy := range []NilPolicy{NilFirst, NilLast, RejectNil} {
+		ch := make(chan interface{})
+		go func() {
+			for _, value := range []interface{}{3, nil, 1, 2, nil} {
+				ch <- value
+			}
+			close(ch)
+		}()
+		tree := BuildFrom(IntSmaller, IntLarger, ch, 2, WithNilPolicy(policy))
+		expected := map[NilPolicy][]interface{}{
+			NilFirst:  {nil, 1, 2, 3},
+			NilLast:   {1, 2, 3, nil},
+			RejectNil: {1, 2, 3},
+		}[policy]
+		if actual := Values(tree); !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Values with policy %d: {Expected: %v | Actual: %v}", policy, expected, actual)
+		}
+		mustCheck(t, tree)
+	}
+}
+
+func TestBuildFromEmpty(t *testing.T) {
+	ch := make(chan interface{})
+	close(ch)
+	tree := BuildFrom(IntSmaller, IntLarger, ch, 4)
+	if expected := 0; expected != tree.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+	}
+	if tree.Minimum() != nil {
+		t.Errorf("Minimum: {Expected: <nil> | Actual: %v}", tree.Minimum())
+	}
+}
@@ -0,0 +1,42 @@
+package bstree
+
+import "bytes"
+
+// Byte slice versions of Smaller and Larger, ordering like bytes.Compare
+func BytesSmaller(value interface{}, other interface{}) bool {
+	return bytes.Compare(value.([]byte), other.([]byte)) < 0
+}
+
+func BytesLarger(value interface{}, other interface{}) bool {
+	return bytes.Compare(value.([]byte), other.([]byte)) > 0
+}
+
+// WithCopyBytes makes the tree store a private copy of every inserted []byte value
+// Byte slices are references, so a caller that reuses its buffer, as
+// network code usually does, would otherwise change the stored value
+// behind the tree's back and corrupt its order. Without this option,
+// []byte values must not be modified after they were inserted. The keys of
+// SyncMap entries are copied likewise. Lookups never copy, and values of
+// other types are stored as they are.
+func WithCopyBytes() Option {
+	return func(tree *Tree) {
+		tree.copyBytes = true
+	}
+}
+
+// own returns the value to store for an inserted value
+// Every path that stores a value the caller handed over goes through it.
+func (tree *Tree) own(value interface{}) interface{} {
+	if !tree.copyBytes {
+		return value
+	}
+	switch v := value.(type) {
+	case []byte:
+		return bytes.Clone(v)
+	case MapEntry:
+		if key, ok := v.Key.([]byte); ok {
+			return MapEntry{bytes.Clone(key), v.Value}
+		}
+	}
+	return value
+}
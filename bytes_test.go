@@ -0,0 +1,122 @@
+package bstree
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func TestTree_CopyBytes(t *testing.T) {
+	tree := New(BytesSmaller, BytesLarger, WithCopyBytes())
+	buffer := make([]byte, 1)
+	for i := 0; i < 10; i++ {
+		buffer[0] = byte(i)
+		tree.Insert(buffer)
+	}
+	if expected := 10; expected != tree.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+	}
+	for i := 0; i < 10; i++ {
+		if !tree.Exists([]byte{byte(i)}) {
+			t.Errorf("Exists(%d): {Expected: true | Actual: false}", i)
+		}
+	}
+	mustCheck(t, tree)
+}
+
+func TestSyncMap_CopyBytes(t *testing.T) {
+	m := NewSyncMap(BytesSmaller, BytesLarger, WithCopyBytes())
+	buffer := []byte("b")
+	m.Store(buffer, 1)
+	copy(buffer, "a")
+	m.Store(buffer, 2)
+	for key, expected := range map[string]int{"a": 2, "b": 1} {
+		if actual, ok := m.Load([]byte(key)); !ok || actual != expected {
+			t.Errorf("Load(%q): {Expected: %d | Actual: %v}", key, expected, actual)
+		}
+	}
+	mustCheck(t, m.Tree())
+}
+
+func TestTree_MapCopyBytes(t *testing.T) {
+	tree := New(BytesSmaller, BytesLarger, WithCopyBytes())
+	tree.Insert([]byte("a"))
+	tree.Insert([]byte("b"))
+	// Every transformed value is written into the same buffer
+	buffer := make([]byte, 1)
+	tree.Map(func(value interface{}) interface{} {
+		buffer[0] = value.([]byte)[0] + 1
+		return buffer
+	})
+	buffer[0] = 'z'
+	if expected, actual := "[[98] [99]]", fmt.Sprint(Values(tree)); expected != actual {
+		t.Errorf("Values: {Expected: %s | Actual: %s}", expected, actual)
+	}
+	mustCheck(t, tree)
+}
+
+func TestTree_UpdateWhereCopyBytes(t *testing.T) {
+	tree := New(BytesSmaller, BytesLarger, WithCopyBytes())
+	tree.Insert([]byte("a"))
+	tree.Insert([]byte("b"))
+	// Every transformed value is a slice of the same scratch array
+	scratch := make([]byte, 2)
+	used := 0
+	updated, err := tree.UpdateWhere(func(value interface{}) bool {
+		return true
+	}, func(value interface{}) interface{} {
+		used += copy(scratch[used:], value.([]byte))
+		return scratch[used-1 : used]
+	})
+	if updated != 2 || err != nil {
+		t.Fatalf("UpdateWhere: {Expected: 2 <nil> | Actual: %d %v}", updated, err)
+	}
+	copy(scratch, "zz")
+	for _, value := range []string{"a", "b"} {
+		if !tree.Exists([]byte(value)) {
+			t.Errorf("Exists(%q): {Expected: true | Actual: false}", value)
+		}
+	}
+	mustCheck(t, tree)
+}
+
+func TestTree_ReplayWALCopyBytes(t *testing.T) {
+	var log bytes.Buffer
+	logged := New(BytesSmaller, BytesLarger, WithWAL(&log))
+	logged.Insert([]byte("a"))
+	logged.Insert([]byte("b"))
+	tree := New(BytesSmaller, BytesLarger, WithCopyBytes())
+	if err := tree.ReplayWAL(&log); err != nil {
+		t.Fatalf("ReplayWAL: {Expected: <nil> | Actual: %v}", err)
+	}
+	if expected, actual := "[[97] [98]]", fmt.Sprint(Values(tree)); expected != actual {
+		t.Errorf("Values: {Expected: %s | Actual: %s}", expected, actual)
+	}
+	mustCheck(t, tree)
+}
+
+func TestTree_ThawCopyBytes(t *testing.T) {
+	buffer := []byte("a")
+	tree := New(BytesSmaller, BytesLarger)
+	tree.Insert(buffer)
+	thawed := tree.Freeze().Thaw(WithCopyBytes())
+	unpacked := tree.Pack(4).Unpack(WithCopyBytes())
+	buffer[0] = 'z'
+	for name, tree := range map[string]*Tree{"Thaw": thawed, "Unpack": unpacked} {
+		if !tree.Exists([]byte("a")) {
+			t.Errorf("%s Exists(\"a\"): {Expected: true | Actual: false}", name)
+		}
+	}
+}
+
+// Index binary keys received into a reused buffer
+func ExampleWithCopyBytes() {
+	tree := New(BytesSmaller, BytesLarger, WithCopyBytes())
+	buffer := []byte("b")
+	tree.Insert(buffer)
+	copy(buffer, "a")
+	tree.Insert(buffer)
+	fmt.Printf("%q %q\n", tree.Minimum(), tree.Maximum())
+	// Output:
+	// "a" "b"
+}
@@ -0,0 +1,112 @@
+package bstree
+
+import "fmt"
+
+// check verifies the structural invariants of the tree
+// It is meant for tests and debugging and must be called with the lock held.
+// Time-complexity: O(size)
+func (tree *Tree) check() error {
+	tree.assertLocked()
+	size, _, err := tree.doCheck(tree.root, nil, nil)
+	if err != nil {
+		return err
+	}
+	if size != tree.size {
+		return fmt.Errorf("bstree: size is %d, but tree has %d nodes", tree.size, size)
+	}
+	if tree.balancing == LLRB && isRed(tree.root) {
+		return fmt.Errorf("bstree: root is red")
+	}
+	if tree.root == nil {
+		return nil
+	}
+	if tree.parents && fat(tree.root).parent != nil {
+		return fmt.Errorf("bstree: root %v has a parent", tree.root.value)
+	}
+	if tree.threads {
+		var prev *_Node
+		var err error
+		tree.doInOrderNodes(tree.root, func(node *_Node) {
+			if err == nil && prev != nil && prev.right() == nil && prev.link != node {
+				err = fmt.Errorf("bstree: node %v is threaded out of order", prev.value)
+			}
+			prev = node
+		})
+		if err == nil && prev.link != nil {
+			err = fmt.Errorf("bstree: largest node %v has a successor", prev.value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if tree.bloom != nil {
+		var err error
+		tree.doInOrder(tree.root, func(value interface{}) {
+			if err == nil && !tree.bloom.mayContain(value) {
+				err = fmt.Errorf("bstree: value %v is missing from the bloom filter", value)
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if tree.smaller(tree.minimum, leftmost(tree.root).value) || tree.larger(tree.minimum, leftmost(tree.root).value) {
+		return fmt.Errorf("bstree: cached minimum %v is stale", tree.minimum)
+	}
+	if tree.smaller(tree.maximum, rightmost(tree.root).value) || tree.larger(tree.maximum, rightmost(tree.root).value) {
+		return fmt.Errorf("bstree: cached maximum %v is stale", tree.maximum)
+	}
+	return nil
+}
+
+// doCheck verifies the subtree rooted at node, whose values must lie within [lo, hi]
+// It returns the number of nodes and the number of black links on every path down.
+func (tree *Tree) doCheck(node *_Node, lo *_Node, hi *_Node) (int, int, error) {
+	if node == nil {
+		return 0, 0, nil
+	}
+	if lo != nil && tree.smaller(node.value, lo.value) {
+		return 0, 0, fmt.Errorf("bstree: %v is left of %v", lo.value, node.value)
+	}
+	if hi != nil && tree.larger(node.value, hi.value) {
+		return 0, 0, fmt.Errorf("bstree: %v is right of %v", hi.value, node.value)
+	}
+	if tree.keyCache != nil && fat(node).pre != tree.keyCache(node.value) {
+		return 0, 0, fmt.Errorf("bstree: node %v has a stale pre-key", node.value)
+	}
+	if (node.thread && !tree.threads) || (tree.threads && !node.thread && node.link == nil) {
+		return 0, 0, fmt.Errorf("bstree: node %v has a stale thread", node.value)
+	}
+	if tree.parents && ((node.left != nil && fat(node.left).parent != node) || (node.right() != nil && fat(node.right()).parent != node)) {
+		return 0, 0, fmt.Errorf("bstree: a child of node %v has a stale parent", node.value)
+	}
+	left, leftBlack, err := tree.doCheck(node.left, lo, node)
+	if err != nil {
+		return 0, 0, err
+	}
+	right, rightBlack, err := tree.doCheck(node.right(), node, hi)
+	if err != nil {
+		return 0, 0, err
+	}
+	if size := left + right + 1; size != node.size {
+		return 0, 0, fmt.Errorf("bstree: node %v has size %d, but %d nodes", node.value, node.size, size)
+	}
+	if tree.merkle != nil && fat(node).digest != tree.valueDigest(node.value)+digestOf(node.left)+digestOf(node.right()) {
+		return 0, 0, fmt.Errorf("bstree: node %v has a stale digest", node.value)
+	}
+	black := leftBlack
+	if tree.balancing == LLRB {
+		switch {
+		case isRed(node.right()):
+			return 0, 0, fmt.Errorf("bstree: node %v has a red right link", node.value)
+		case isRed(node) && isRed(node.left):
+			return 0, 0, fmt.Errorf("bstree: node %v has two red links in a row", node.value)
+		case leftBlack != rightBlack:
+			return 0, 0, fmt.Errorf("bstree: node %v has unbalanced black heights %d and %d", node.value, leftBlack, rightBlack)
+		}
+		if !isRed(node) {
+			black++
+		}
+	}
+	return left + right + 1, black, nil
+}
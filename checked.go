@@ -0,0 +1,132 @@
+package bstree
+
+import "errors"
+
+// CompareE is a three-way comparator that can reject its input
+// It returns a negative number, zero or a positive number when value is
+// smaller than, equal to or larger than other respectively, or an error
+// if the two cannot be compared, e.g. because of a wrong dynamic type.
+type CompareE func(value interface{}, other interface{}) (int, error)
+
+// _CompareError carries an error returned by a CompareE out of the tree
+type _CompareError struct {
+	err error
+}
+
+func (ce _CompareError) Error() string {
+	return "bstree: comparator failed: " + ce.err.Error()
+}
+
+func (ce _CompareError) Unwrap() error {
+	return ce.err
+}
+
+// mustCompare calls compare and panics with a _CompareError if it fails
+func mustCompare(compare CompareE, value interface{}, other interface{}) int {
+	result, err := compare(value, other)
+	if err != nil {
+		panic(_CompareError{err})
+	}
+	return result
+}
+
+// recoverCompare turns a panicking comparator back into an error
+// Other panics are turned into errors for trees created WithPanicRecovery.
+// It has to be deferred directly by the method returning err.
+func (tree *Tree) recoverCompare(err *error) {
+	if r := recover(); r != nil {
+		if ce, ok := r.(_CompareError); ok {
+			*err = ce.err
+			return
+		}
+		if tree.recovery == nil {
+			panic(r)
+		}
+		*err = panicError(r)
+	}
+}
+
+// NewE creates an initialized tree ordered by a comparator that can fail
+// InsertE, ExistsE and DeleteE return the errors of the comparator and
+// leave the tree unchanged; all other methods panic with them.
+// Time-complexity: O(1)
+func NewE(compare CompareE, options ...Option) *Tree {
+	smaller := func(value interface{}, other interface{}) bool {
+		return mustCompare(compare, value, other) < 0
+	}
+	larger := func(value interface{}, other interface{}) bool {
+		return mustCompare(compare, value, other) > 0
+	}
+	return newTree(smaller, larger, func(value interface{}, other interface{}) int {
+		return mustCompare(compare, value, other)
+	}, options...)
+}
+
+// Errors returned by InsertE and DeleteE for valid values that cannot be inserted or deleted
+var (
+	ErrDuplicate = errors.New("bstree: value already exists")
+	ErrNotFound  = errors.New("bstree: value not found")
+)
+
+// checkValue returns the error of an E variant for a value the tree does not accept
+// Unless the tree orders nil itself, nil is refused with ErrNilValue.
+func (tree *Tree) checkValue(value interface{}) error {
+	if value == nil && tree.nilPolicy != NilFirst && tree.nilPolicy != NilLast {
+		return ErrNilValue
+	}
+	return tree.checkType(value)
+}
+
+// InsertE is like Insert but reports why a value was not inserted
+// It returns ErrDuplicate if the value already exists, ErrNilValue for
+// nil, ErrTypeMismatch for values of the wrong type in trees created
+// WithType, and the error of a failing comparator. Use errors.Is to
+// tell them apart.
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (tree *Tree) InsertE(value interface{}) (err error) {
+	if err := tree.checkValue(value); err != nil {
+		return err
+	}
+	defer tree.recoverCompare(&err)
+	inserted, err := tree.insertValue(value, true)
+	if err != nil {
+		return err
+	}
+	if !inserted {
+		return ErrDuplicate
+	}
+	return nil
+}
+
+// ExistsE is like Exists but returns the error of a failing comparator,
+// ErrNilValue for nil and ErrTypeMismatch for values of the wrong type
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (tree *Tree) ExistsE(value interface{}) (exists bool, err error) {
+	if err := tree.checkValue(value); err != nil {
+		return false, err
+	}
+	defer tree.recoverCompare(&err)
+	return tree.exists(value, true)
+}
+
+// DeleteE is like Delete but reports why a value was not deleted
+// It returns ErrNotFound if the value does not exist and otherwise
+// the same errors as InsertE.
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (tree *Tree) DeleteE(value interface{}) (err error) {
+	if err := tree.checkValue(value); err != nil {
+		return err
+	}
+	defer tree.recoverCompare(&err)
+	deleted, err := tree.deleteValue(value)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package bstree
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+var errNotInt = errors.New("not an int")
+
+// intCompareE compares ints and rejects all other types
+func intCompareE(value interface{}, other interface{}) (int, error) {
+	a, ok := value.(int)
+	if !ok {
+		return 0, errNotInt
+	}
+	b, ok := other.(int)
+	if !ok {
+		return 0, errNotInt
+	}
+	return a - b, nil
+}
+
+func TestTree_CompareE(t *testing.T) {
+	tree := NewE(intCompareE)
+	for i := 0; i < 10; i++ {
+		if err := tree.InsertE(i); err != nil {
+			t.Errorf("InsertE(%d): {Expected: <nil> | Actual: %v}", i, err)
+		}
+	}
+	if err := tree.InsertE("five"); err != errNotInt {
+		t.Errorf("InsertE(five): {Expected: %v | Actual: %v}", errNotInt, err)
+	}
+	if err := tree.InsertE(5); err != ErrDuplicate {
+		t.Errorf("InsertE(5): {Expected: %v | Actual: %v}", ErrDuplicate, err)
+	}
+	if exists, err := tree.ExistsE(5.0); exists || err != errNotInt {
+		t.Errorf("ExistsE(5.0): {Expected: false %v | Actual: %t %v}", errNotInt, exists, err)
+	}
+	if err := tree.DeleteE(nil); err != ErrNilValue {
+		t.Errorf("DeleteE(nil): {Expected: %v | Actual: %v}", ErrNilValue, err)
+	}
+	if err := tree.DeleteE(5); err != nil {
+		t.Errorf("DeleteE(5): {Expected: <nil> | Actual: %v}", err)
+	}
+	if err := tree.DeleteE(5); err != ErrNotFound {
+		t.Errorf("DeleteE(5): {Expected: %v | Actual: %v}", ErrNotFound, err)
+	}
+	if expected := 9; expected != tree.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+	}
+	if tree.Minimum() != 0 || tree.Maximum() != 9 {
+		t.Errorf("MinMax: {Expected: 0,9 | Actual: %v,%v}", tree.Minimum(), tree.Maximum())
+	}
+}
+
+func TestTree_CompareEPanics(t *testing.T) {
+	tree := NewE(intCompareE)
+	tree.Insert(1)
+	defer func() {
+		r := recover()
+		if err, ok := r.(error); !ok || !errors.Is(err, errNotInt) {
+			t.Errorf("Panic: {Expected: %v | Actual: %v}", errNotInt, r)
+		}
+	}()
+	tree.Insert("one")
+}
+
+// Get comparator errors returned instead of panics
+func ExampleNewE() {
+	tree := NewE(intCompareE)
+	fmt.Println(tree.InsertE(1))
+	fmt.Println(tree.InsertE("two"))
+	fmt.Println(tree.InsertE(1))
+	// Output:
+	// <nil>
+	// not an int
+	// bstree: value already exists
+}
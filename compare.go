@@ -0,0 +1,65 @@
+package bstree
+
+import "cmp"
+
+// Compare is a three-way comparator
+// It returns a negative number, zero or a positive number when value is
+// smaller than, equal to or larger than other respectively.
+type Compare func(value interface{}, other interface{}) int
+
+// Smaller is the Smaller comparator derived from compare
+func (compare Compare) Smaller(value interface{}, other interface{}) bool {
+	return compare(value, other) < 0
+}
+
+// Larger is the Larger comparator derived from compare
+func (compare Compare) Larger(value interface{}, other interface{}) bool {
+	return compare(value, other) > 0
+}
+
+// NewCompare creates an initialized tree ordered by a three-way comparator
+// Time-complexity: O(1)
+func NewCompare(compare Compare, options ...Option) *Tree {
+	return newTree(compare.Smaller, compare.Larger, compare, options...)
+}
+
+// deriveCompare builds a three-way comparator from a pair of comparators
+// It calls smaller first, so it needs two calls unless value is smaller.
+func deriveCompare(smaller Smaller, larger Larger) Compare {
+	return func(value interface{}, other interface{}) int {
+		switch {
+		case smaller(value, other):
+			return -1
+		case larger(value, other):
+			return 1
+		}
+		return 0
+	}
+}
+
+// OrderedCompare is the Compare version of OrderedSmaller and OrderedLarger
+func OrderedCompare[T cmp.Ordered](value interface{}, other interface{}) int {
+	return cmp.Compare(value.(T), other.(T))
+}
+
+// CompositeComparator chains comparators lexicographically
+// Values are ordered by the first comparator, ties are broken by the
+// second one and so on. Values equal under all comparators are equal.
+// Typical comparators compare a single field, e.g. a timestamp then an ID.
+func CompositeComparator(compares ...Compare) Compare {
+	return func(value interface{}, other interface{}) int {
+		for _, compare := range compares {
+			if result := compare(value, other); result != 0 {
+				return result
+			}
+		}
+		return 0
+	}
+}
+
+// Reversed inverts the order of a comparator, e.g. for descending trees
+func Reversed(compare Compare) Compare {
+	return func(value interface{}, other interface{}) int {
+		return compare(other, value)
+	}
+}
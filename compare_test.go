@@ -0,0 +1,113 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+type event struct {
+	time int
+	id   string
+}
+
+var (
+	byTime = func(value interface{}, other interface{}) int {
+		return OrderedCompare[int](value.(event).time, other.(event).time)
+	}
+	byID = func(value interface{}, other interface{}) int {
+		return OrderedCompare[string](value.(event).id, other.(event).id)
+	}
+)
+
+func TestCompositeComparator(t *testing.T) {
+	compare := CompositeComparator(byTime, Reversed(byID))
+	tests := []struct {
+		value, other event
+		expected     int
+	}{
+		{event{1, "a"}, event{2, "a"}, -1},
+		{event{2, "a"}, event{1, "b"}, 1},
+		{event{1, "a"}, event{1, "b"}, 1},
+		{event{1, "b"}, event{1, "b"}, 0},
+	}
+	for _, test := range tests {
+		if actual := compare(test.value, test.other); actual != test.expected {
+			t.Errorf("Compare(%v, %v): {Expected: %d | Actual: %d}", test.value, test.other, test.expected, actual)
+		}
+	}
+	if actual := CompositeComparator()(event{1, "a"}, event{2, "b"}); actual != 0 {
+		t.Errorf("Empty: {Expected: 0 | Actual: %d}", actual)
+	}
+}
+
+func TestNewCompare(t *testing.T) {
+	tree := NewCompare(Reversed(OrderedCompare[int]))
+	for i := 0; i < 10; i++ {
+		tree.Insert(i)
+	}
+	if tree.Minimum() != 9 || tree.Maximum() != 0 {
+		t.Errorf("MinMax: {Expected: 9,0 | Actual: %v,%v}", tree.Minimum(), tree.Maximum())
+	}
+	mustCheck(t, tree)
+}
+
+func TestNewCompare_Calls(t *testing.T) {
+	calls := 0
+	tree := NewCompare(func(value interface{}, other interface{}) int {
+		calls++
+		return value.(int) - other.(int)
+	})
+	CompleteTree(127).Traverse(PreOrder, func(value interface{}) {
+		tree.Insert(value)
+	})
+	for _, value := range []int{1, 64, 127, 500} {
+		calls = 0
+		tree.Exists(value)
+		if calls > 7 {
+			t.Errorf("Calls of Exists(%d): {Expected: <= 7 | Actual: %d}", value, calls)
+		}
+	}
+}
+
+func TestNewCompare_InsertDeleteCalls(t *testing.T) {
+	if debug {
+		t.Skip("debug builds compare while checking each modification")
+	}
+	calls := 0
+	tree := NewCompare(func(value interface{}, other interface{}) int {
+		calls++
+		return value.(int) - other.(int)
+	})
+	CompleteTree(127).Traverse(PreOrder, func(value interface{}) {
+		tree.Insert(value)
+	})
+	// New extremes are found on the way down, so each level costs one call
+	for _, value := range []int{0, 200} {
+		calls = 0
+		tree.Insert(value)
+		if expected := 7; expected != calls {
+			t.Errorf("Calls of Insert(%d): {Expected: %d | Actual: %d}", value, expected, calls)
+		}
+		calls = 0
+		tree.Delete(value)
+		if expected := 8; expected != calls {
+			t.Errorf("Calls of Delete(%d): {Expected: %d | Actual: %d}", value, expected, calls)
+		}
+		mustCheck(t, tree)
+	}
+}
+
+// Order events by time, then by ID
+func ExampleCompositeComparator() {
+	tree := NewCompare(CompositeComparator(byTime, byID))
+	tree.Insert(event{2, "b"})
+	tree.Insert(event{1, "z"})
+	tree.Insert(event{2, "a"})
+	tree.Traverse(InOrder, func(value interface{}) {
+		fmt.Println(value)
+	})
+	// Output:
+	// {1 z}
+	// {2 a}
+	// {2 b}
+}
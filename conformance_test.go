@@ -0,0 +1,155 @@
+package bstree
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// backends lists every balancing algorithm, so each one runs through the same suite
+var backends = []struct {
+	name      string
+	balancing Balancing
+}{
+	{"Unbalanced", Unbalanced},
+	{"LLRB", LLRB},
+	{"Splay", Splay},
+}
+
+// forEachBackend runs test once per backend with the option selecting it
+func forEachBackend(t *testing.T, test func(t *testing.T, backend Option)) {
+	for _, backend := range backends {
+		t.Run(backend.name, func(t *testing.T) {
+			test(t, WithBalancing(backend.balancing))
+		})
+	}
+}
+
+// mustCheck fails the test if the invariants of the tree are violated
+func mustCheck(t *testing.T, tree *Tree) {
+	t.Helper()
+	tree.mutex.RLock()
+	defer tree.mutex.RUnlock()
+	if err := tree.check(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConformance_RandomOperations(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend)
+		present := make(map[int]bool)
+		for i := 0; i < 3000; i++ {
+			value := rand.Intn(300)
+			if rand.Intn(3) == 0 {
+				if expected := present[value]; expected != tree.Delete(value) {
+					t.Fatalf("Delete(%d): {Expected: %t | Actual: %t}", value, expected, !expected)
+				}
+				delete(present, value)
+			} else {
+				if expected := !present[value]; expected != tree.Insert(value) {
+					t.Fatalf("Insert(%d): {Expected: %t | Actual: %t}", value, expected, !expected)
+				}
+				present[value] = true
+			}
+			mustCheck(t, tree)
+		}
+		for value := 0; value < 300; value++ {
+			if present[value] != tree.Exists(value) {
+				t.Errorf("Exists(%d): {Expected: %t | Actual: %t}", value, present[value], !present[value])
+			}
+		}
+	})
+}
+
+func TestConformance_Duplicates(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(func(value interface{}, other interface{}) bool {
+			return value.(record).key < other.(record).key
+		}, func(value interface{}, other interface{}) bool {
+			return value.(record).key > other.(record).key
+		}, WithDuplicates(), backend)
+		for i := 0; i < 500; i++ {
+			tree.Insert(record{key: i % 7, name: fmt.Sprintf("%03d", i)})
+			mustCheck(t, tree)
+		}
+		for i := 0; i < 7; i++ {
+			tree.Delete(record{key: i})
+			mustCheck(t, tree)
+		}
+		previous := record{key: -1}
+		tree.Traverse(InOrder, func(value interface{}) {
+			current := value.(record)
+			if previous.key > current.key || (previous.key == current.key && previous.name > current.name) {
+				t.Errorf("Order: {Previous: %v | Current: %v}", previous, current)
+			}
+			previous = current
+		})
+	})
+}
+
+func TestConformance_RandomDuplicates(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, WithDuplicates(), backend)
+		counts := make(map[int]int)
+		size := 0
+		for i := 0; i < 3000; i++ {
+			value := rand.Intn(20)
+			if rand.Intn(2) == 0 {
+				if expected := counts[value] > 0; expected != tree.Delete(value) {
+					t.Fatalf("Delete(%d): {Expected: %t | Actual: %t}", value, expected, !expected)
+				}
+				if counts[value] > 0 {
+					counts[value]--
+					size--
+				}
+			} else {
+				tree.Insert(value)
+				counts[value]++
+				size++
+			}
+			mustCheck(t, tree)
+			if values := Values(tree); len(values) != size {
+				t.Fatalf("Values: {Expected: %d values | Actual: %d values}", size, len(values))
+			}
+		}
+		for value, count := range counts {
+			if actual := tree.CountRange(Range{GTE: value, LTE: value}); actual != count {
+				t.Errorf("Count(%d): {Expected: %d | Actual: %d}", value, count, actual)
+			}
+		}
+	})
+}
+
+func TestConformance_Load(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		ch := make(chan interface{})
+		go func() {
+			for _, value := range rand.Perm(1000) {
+				ch <- value
+			}
+			close(ch)
+		}()
+		tree := BuildFrom(IntSmaller, IntLarger, ch, 4, backend)
+		mustCheck(t, tree)
+		for i := 0; i < 1000; i += 2 {
+			tree.Delete(i)
+			mustCheck(t, tree)
+		}
+		if expected := 500; expected != tree.Size() {
+			t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+		}
+	})
+}
+
+func TestLLRB_Depth(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithBalancing(LLRB))
+	for i := 0; i < 10000; i++ {
+		tree.Insert(i)
+	}
+	if limit := int(2 * math.Log2(float64(tree.Size()+1))); tree.Depth() > limit {
+		t.Errorf("Depth: {Expected: <= %d | Actual: %d}", limit, tree.Depth())
+	}
+	mustCheck(t, tree)
+}
@@ -0,0 +1,177 @@
+package bstree
+
+// Cursor moves back and forth over the values of a tree, like a bbolt cursor
+// Every method positions the cursor and returns the value it lands on, or
+// false once it moves past either end. Like an Iterator, a cursor only
+// holds the lock during each call, so the tree may be modified between
+// calls. A cursor that notices a modification finds its way back by
+// value, continuing with the next larger or smaller value.
+type Cursor struct {
+	tree    *Tree
+	path    []*_Node // nodes from the root down to the current node, empty if not positioned
+	version uint64   // version of the tree the path belongs to
+	value   interface{}
+}
+
+// Cursor returns a cursor over the tree that is not positioned yet
+// Time-complexity: O(1)
+func (tree *Tree) Cursor() *Cursor {
+	return &Cursor{tree: tree}
+}
+
+// First moves the cursor to the smallest value
+// Time-complexity: O(depth)
+func (cursor *Cursor) First() (interface{}, bool) {
+	tree := cursor.tree
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	cursor.path = cursor.path[:0]
+	if tree.root != nil {
+		cursor.descend(tree.root, true)
+	}
+	return cursor.current()
+}
+
+// Last moves the cursor to the largest value
+// Time-complexity: O(depth)
+func (cursor *Cursor) Last() (interface{}, bool) {
+	tree := cursor.tree
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	cursor.path = cursor.path[:0]
+	if tree.root != nil {
+		cursor.descend(tree.root, false)
+	}
+	return cursor.current()
+}
+
+// Seek moves the cursor to the smallest value larger than or equal to value
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (cursor *Cursor) Seek(value interface{}) (interface{}, bool) {
+	tree := cursor.tree
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	cursor.seek(value, false)
+	return cursor.current()
+}
+
+// Next moves the cursor to the next value
+// It returns false, without moving, if the cursor isn't positioned.
+// Time-complexity: O(1) amortized, O(depth) after a modification
+func (cursor *Cursor) Next() (interface{}, bool) {
+	tree := cursor.tree
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	if len(cursor.path) == 0 {
+		return nil, false
+	}
+	if cursor.version != tree.version {
+		cursor.seek(cursor.value, true)
+		return cursor.current()
+	}
+	cursor.step(true)
+	return cursor.current()
+}
+
+// Prev moves the cursor to the previous value
+// It returns false, without moving, if the cursor isn't positioned.
+// Time-complexity: O(1) amortized, O(depth) after a modification
+func (cursor *Cursor) Prev() (interface{}, bool) {
+	tree := cursor.tree
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	if len(cursor.path) == 0 {
+		return nil, false
+	}
+	if cursor.version != tree.version {
+		cursor.seekBefore(cursor.value)
+		return cursor.current()
+	}
+	cursor.step(false)
+	return cursor.current()
+}
+
+// current records the position of the cursor and returns its value
+func (cursor *Cursor) current() (interface{}, bool) {
+	cursor.version = cursor.tree.version
+	if len(cursor.path) == 0 {
+		cursor.value = nil
+		return nil, false
+	}
+	cursor.value = cursor.path[len(cursor.path)-1].value
+	return cursor.value, true
+}
+
+// descend appends node and its chain of left (or right) children to the path
+func (cursor *Cursor) descend(node *_Node, left bool) {
+	for node != nil {
+		cursor.path = append(cursor.path, node)
+		if left {
+			node = node.left
+		} else {
+			node = node.right()
+		}
+	}
+}
+
+// step moves the path to the in-order successor (or predecessor) of the current node
+func (cursor *Cursor) step(forward bool) {
+	node := cursor.path[len(cursor.path)-1]
+	if forward && node.right() != nil {
+		cursor.descend(node.right(), true)
+		return
+	}
+	if !forward && node.left != nil {
+		cursor.descend(node.left, false)
+		return
+	}
+	// Climb until arriving from the left (or right) child
+	for {
+		child := cursor.path[len(cursor.path)-1]
+		cursor.path = cursor.path[:len(cursor.path)-1]
+		if len(cursor.path) == 0 {
+			return
+		}
+		parent := cursor.path[len(cursor.path)-1]
+		if (forward && parent.left == child) || (!forward && parent.right() == child) {
+			return
+		}
+	}
+}
+
+// seek positions the path at the smallest value larger than (or equal to) value
+func (cursor *Cursor) seek(value interface{}, strict bool) {
+	tree := cursor.tree
+	cursor.path = cursor.path[:0]
+	found := 0
+	pre := tree.preKey(value)
+	for node := tree.root; node != nil; {
+		cursor.path = append(cursor.path, node)
+		if order := tree.order(value, pre, node); order < 0 || (order == 0 && !strict) {
+			found = len(cursor.path)
+			node = node.left
+		} else {
+			node = node.right()
+		}
+	}
+	cursor.path = cursor.path[:found]
+}
+
+// seekBefore positions the path at the largest value smaller than value
+func (cursor *Cursor) seekBefore(value interface{}) {
+	tree := cursor.tree
+	cursor.path = cursor.path[:0]
+	found := 0
+	pre := tree.preKey(value)
+	for node := tree.root; node != nil; {
+		cursor.path = append(cursor.path, node)
+		if tree.order(value, pre, node) > 0 {
+			found = len(cursor.path)
+			node = node.right()
+		} else {
+			node = node.left
+		}
+	}
+	cursor.path = cursor.path[:found]
+}
@@ -0,0 +1,79 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCursor(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend)
+		for i := 0; i < 200; i += 2 {
+			tree.Insert(i)
+		}
+		cursor := tree.Cursor()
+		if value, ok := cursor.Next(); ok {
+			t.Errorf("Next before positioning: {Expected: <nil> false | Actual: %v %t}", value, ok)
+		}
+		expected := 0
+		for value, ok := cursor.First(); ok; value, ok = cursor.Next() {
+			if expected != value {
+				t.Errorf("Next: {Expected: %d | Actual: %v}", expected, value)
+			}
+			expected += 2
+		}
+		if expected != 200 {
+			t.Errorf("Values visited forward: {Expected: 100 | Actual: %d}", expected/2)
+		}
+		expected = 198
+		for value, ok := cursor.Last(); ok; value, ok = cursor.Prev() {
+			if expected != value {
+				t.Errorf("Prev: {Expected: %d | Actual: %v}", expected, value)
+			}
+			expected -= 2
+		}
+		if value, ok := cursor.Seek(51); !ok || value != 52 {
+			t.Errorf("Seek(51): {Expected: 52 true | Actual: %v %t}", value, ok)
+		}
+		if value, ok := cursor.Seek(52); !ok || value != 52 {
+			t.Errorf("Seek(52): {Expected: 52 true | Actual: %v %t}", value, ok)
+		}
+		if value, ok := cursor.Seek(199); ok {
+			t.Errorf("Seek(199): {Expected: <nil> false | Actual: %v %t}", value, ok)
+		}
+	})
+}
+
+func TestCursor_Modified(t *testing.T) {
+	tree := New(IntSmaller, IntLarger)
+	for i := 0; i < 10; i++ {
+		tree.Insert(i * 10)
+	}
+	cursor := tree.Cursor()
+	cursor.Seek(40)
+	tree.Delete(50)
+	tree.Insert(45)
+	if value, ok := cursor.Next(); !ok || value != 45 {
+		t.Errorf("Next: {Expected: 45 true | Actual: %v %t}", value, ok)
+	}
+	tree.Delete(40)
+	if value, ok := cursor.Prev(); !ok || value != 30 {
+		t.Errorf("Prev: {Expected: 30 true | Actual: %v %t}", value, ok)
+	}
+}
+
+// Scan forward from a seek position
+func ExampleCursor() {
+	tree := New(IntSmaller, IntLarger)
+	for _, value := range []int{5, 2, 8, 1, 9, 3} {
+		tree.Insert(value)
+	}
+	cursor := tree.Cursor()
+	for value, ok := cursor.Seek(4); ok; value, ok = cursor.Next() {
+		fmt.Println(value)
+	}
+	// Output:
+	// 5
+	// 8
+	// 9
+}
@@ -0,0 +1,8 @@
+//go:build bstree_debug
+
+package bstree
+
+// debug hardens every tree: assertions are enabled and the invariants are
+// checked after each modification. Build with -tags bstree_debug to run a
+// test suite against the hardened build.
+const debug = true
@@ -0,0 +1,17 @@
+//go:build bstree_debug
+
+package bstree
+
+import "testing"
+
+func TestDebug_Verify(t *testing.T) {
+	tree := RandomTree(50, 100)
+	// Break the ordering behind the back of the tree
+	tree.root.value = -1
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Insert into corrupt tree: {Expected: panic | Actual: no panic}")
+		}
+	}()
+	tree.Insert(1000)
+}
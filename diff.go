@@ -0,0 +1,39 @@
+package bstree
+
+// Diff compares the tree with other by walking both in order
+// added holds the values only present in other, removed the values only
+// present in the tree, both in sorted order. The comparators of the tree
+// are used for both. Each tree is copied under its own lock, so the trees
+// are never locked at the same time.
+// Time-complexity: O(size + other.size)
+func (tree *Tree) Diff(other *Tree) (added []interface{}, removed []interface{}) {
+	if tree == other {
+		return nil, nil
+	}
+	tree.rlock()
+	mine := tree.values()
+	tree.mutex.RUnlock()
+	other.rlock()
+	theirs := other.values()
+	other.mutex.RUnlock()
+	return tree.diffValues(mine, theirs)
+}
+
+// diffValues compares two sorted slices of values using the comparators of the tree
+func (tree *Tree) diffValues(mine []interface{}, theirs []interface{}) (added []interface{}, removed []interface{}) {
+	for len(mine) > 0 && len(theirs) > 0 {
+		switch {
+		case tree.smaller(mine[0], theirs[0]):
+			removed = append(removed, mine[0])
+			mine = mine[1:]
+		case tree.larger(mine[0], theirs[0]):
+			added = append(added, theirs[0])
+			theirs = theirs[1:]
+		default:
+			mine, theirs = mine[1:], theirs[1:]
+		}
+	}
+	removed = append(removed, mine...)
+	added = append(added, theirs...)
+	return added, removed
+}
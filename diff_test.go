@@ -0,0 +1,39 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_Diff(t *testing.T) {
+	before := RandomTree(300, 500)
+	after := RandomTree(300, 500)
+	added, removed := before.Diff(after)
+	for _, value := range added {
+		if before.Exists(value) || !after.Exists(value) {
+			t.Errorf("Added %d: {Before: %t | After: %t}", value, before.Exists(value), after.Exists(value))
+		}
+	}
+	for _, value := range removed {
+		if !before.Exists(value) || after.Exists(value) {
+			t.Errorf("Removed %d: {Before: %t | After: %t}", value, before.Exists(value), after.Exists(value))
+		}
+	}
+	if expected := after.Size() - before.Size(); expected != len(added)-len(removed) {
+		t.Errorf("Size change: {Expected: %d | Actual: %d}", expected, len(added)-len(removed))
+	}
+	if added, removed := before.Diff(before); added != nil || removed != nil {
+		t.Errorf("Diff with itself: {Expected: [] [] | Actual: %v %v}", added, removed)
+	}
+}
+
+// Compute the changes between two snapshots
+func ExampleTree_Diff() {
+	before := CompleteTree(5)
+	after := CompleteTree(7)
+	after.Delete(2)
+	added, removed := before.Diff(after)
+	fmt.Println(added, removed)
+	// Output:
+	// [6 7] [2]
+}
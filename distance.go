@@ -0,0 +1,50 @@
+package bstree
+
+// Distance returns the number of edges on the path between the nodes holding a and b
+// The path leads up from a to their lowest common ancestor and down to b.
+// Returns false if a or b doesn't exist. Trees created WithDuplicates
+// use the topmost equal values.
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (tree *Tree) Distance(a interface{}, b interface{}) (int, bool) {
+	defer tree.recoverPanic()
+	if tree.checkType(a) != nil || tree.checkType(b) != nil {
+		return 0, false
+	}
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	// Descend while both values lie on the same side
+	preA, preB := tree.preKey(a), tree.preKey(b)
+	ancestor := tree.root
+	for ancestor != nil {
+		orderA, orderB := tree.order(a, preA, ancestor), tree.order(b, preB, ancestor)
+		if orderA < 0 && orderB < 0 {
+			ancestor = ancestor.left
+		} else if orderA > 0 && orderB > 0 {
+			ancestor = ancestor.right()
+		} else {
+			break
+		}
+	}
+	up, foundA := tree.edgesTo(ancestor, a, preA)
+	down, foundB := tree.edgesTo(ancestor, b, preB)
+	if !foundA || !foundB {
+		return 0, false
+	}
+	return up + down, true
+}
+
+// edgesTo returns the number of edges from node down to the node holding value, whose pre-key is pre
+func (tree *Tree) edgesTo(node *_Node, value interface{}, pre uint64) (int, bool) {
+	for edges := 0; node != nil; edges++ {
+		switch order := tree.order(value, pre, node); {
+		case order < 0:
+			node = node.left
+		case order > 0:
+			node = node.right()
+		default:
+			return edges, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,42 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_Distance(t *testing.T) {
+	tree := CompleteTree(15)
+	for _, test := range []struct {
+		a, b     int
+		expected int
+		found    bool
+	}{
+		{8, 8, 0, true},
+		{8, 4, 1, true},
+		{1, 3, 2, true},
+		{1, 15, 6, true},
+		{5, 7, 2, true},
+		{5, 12, 4, true},
+		{1, 16, 0, false},
+		{0, 2, 0, false},
+	} {
+		for _, pair := range [][2]int{{test.a, test.b}, {test.b, test.a}} {
+			distance, found := tree.Distance(pair[0], pair[1])
+			if test.expected != distance || test.found != found {
+				t.Errorf("Distance(%d, %d): {Expected: %d %t | Actual: %d %t}", pair[0], pair[1], test.expected, test.found, distance, found)
+			}
+		}
+	}
+	if _, found := EmptyTree().Distance(1, 1); found {
+		t.Errorf("Distance in empty tree: {Expected: false | Actual: true}")
+	}
+}
+
+// Count the edges between two values
+func ExampleTree_Distance() {
+	tree := CompleteTree(7)
+	fmt.Println(tree.Distance(1, 7))
+	// Output:
+	// 4 true
+}
@@ -0,0 +1,57 @@
+package bstree
+
+// WithDuplicates makes the tree a multiset that keeps equal values
+// Equal values are kept in insertion order, so traversals are stable.
+func WithDuplicates() Option {
+	return func(tree *Tree) {
+		tree.duplicates = true
+	}
+}
+
+// WithInsertionOrder makes the tree a multiset that behaves like a queue for equal values
+// Equal values iterate in insertion order, as with WithDuplicates, and Delete
+// removes the earliest inserted of them instead of whichever one it reaches
+// first. The values that remain are therefore always in arrival order, which
+// matters for event logs where ties must preserve the order they came in.
+// Worst case time-complexity of Delete: O(depth^2), for long runs of equal values
+func WithInsertionOrder() Option {
+	return func(tree *Tree) {
+		tree.duplicates = true
+		tree.fifo = true
+	}
+}
+
+// deleteOrder is order, but steers deletes of equal values to the earliest inserted one
+// The earliest inserted of the equal values is the first one in order, so
+// for a tree with insertion order an equal node defers to its left subtree
+// whenever the largest value there is equal as well.
+func (tree *Tree) deleteOrder(value interface{}, pre uint64, node *_Node) int {
+	order := tree.order(value, pre, node)
+	if order != 0 || !tree.fifo || node.left == nil {
+		return order
+	}
+	rightmost := node.left
+	for rightmost.right() != nil {
+		rightmost = rightmost.right()
+	}
+	if tree.order(value, pre, rightmost) == 0 {
+		return -1
+	}
+	return 0
+}
+
+// SortRecords returns the records sorted by less, keeping equal records in their original order
+// The records are sorted by inserting them into an LLRB tree with duplicates.
+// Time-complexity: O(size * log(size))
+func SortRecords(records []interface{}, less func(a interface{}, b interface{}) bool) []interface{} {
+	larger := func(a interface{}, b interface{}) bool {
+		return less(b, a)
+	}
+	tree := New(Smaller(less), larger, WithDuplicates(), WithBalancing(LLRB))
+	tree.mutex.Lock()
+	defer tree.mutex.Unlock()
+	for _, record := range records {
+		tree.insert(record)
+	}
+	return tree.values()
+}
@@ -0,0 +1,130 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_Duplicates(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithDuplicates())
+	for i := 0; i < 100; i++ {
+		if !tree.Insert(i % 10) {
+			t.Errorf("Insert(%d): {Expected: true | Actual: false}", i%10)
+		}
+	}
+	if expected := 100; expected != tree.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+	}
+	for i := 0; i < 9; i++ {
+		tree.Delete(3)
+	}
+	if !tree.Exists(3) {
+		t.Errorf("Exists(3): {Expected: true | Actual: false}")
+	}
+	tree.Delete(3)
+	if tree.Exists(3) {
+		t.Errorf("Exists(3): {Expected: false | Actual: true}")
+	}
+	if expected := 90; expected != tree.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+	}
+}
+
+type record struct {
+	key  int
+	name string
+}
+
+func TestSortRecords_Stable(t *testing.T) {
+	var records []interface{}
+	for i := 0; i < 200; i++ {
+		records = append(records, record{key: (i * 7) % 5, name: fmt.Sprintf("%03d", i)})
+	}
+	sorted := SortRecords(records, func(a interface{}, b interface{}) bool {
+		return a.(record).key < b.(record).key
+	})
+	if len(records) != len(sorted) {
+		t.Fatalf("Length: {Expected: %d | Actual: %d}", len(records), len(sorted))
+	}
+	for i := 1; i < len(sorted); i++ {
+		previous, current := sorted[i-1].(record), sorted[i].(record)
+		if previous.key > current.key || (previous.key == current.key && previous.name > current.name) {
+			t.Errorf("Order at %d: {Previous: %v | Current: %v}", i, previous, current)
+		}
+	}
+}
+
+func TestSortRecords_Sorted(t *testing.T) {
+	var records []interface{}
+	for i := 0; i < 100000; i++ {
+		records = append(records, record{key: i / 2, name: fmt.Sprintf("%06d", i)})
+	}
+	sorted := SortRecords(records, func(a interface{}, b interface{}) bool {
+		return a.(record).key < b.(record).key
+	})
+	for i := range records {
+		if records[i] != sorted[i] {
+			t.Fatalf("Record %d: {Expected: %v | Actual: %v}", i, records[i], sorted[i])
+		}
+	}
+}
+
+func TestWithInsertionOrder_Delete(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		byKey := func(a interface{}, b interface{}) bool { return a.(record).key < b.(record).key }
+		tree := New(byKey, func(a interface{}, b interface{}) bool { return byKey(b, a) }, backend, WithInsertionOrder())
+		var expected []interface{}
+		for i := 0; i < 300; i++ {
+			tree.Insert(record{key: (i * 7) % 5, name: fmt.Sprintf("%03d", i)})
+		}
+		for i := 0; i < 100; i++ {
+			if !tree.Delete(record{key: i % 5}) {
+				t.Errorf("Delete(%d): {Expected: true | Actual: false}", i%5)
+			}
+		}
+		mustCheck(t, tree)
+		// The earliest 20 records of every key are gone
+		seen := make(map[int]int)
+		for i := 0; i < 300; i++ {
+			key := (i * 7) % 5
+			if seen[key]++; seen[key] > 20 {
+				expected = append(expected, record{key: key, name: fmt.Sprintf("%03d", i)})
+			}
+		}
+		expected = SortRecords(expected, byKey)
+		actual := Values(tree)
+		if fmt.Sprint(expected) != fmt.Sprint(actual) {
+			t.Errorf("Values: {Expected: %v | Actual: %v}", expected, actual)
+		}
+	})
+}
+
+// Delete the earliest of equal events
+func ExampleWithInsertionOrder() {
+	byKey := func(a interface{}, b interface{}) bool { return a.(record).key < b.(record).key }
+	tree := New(byKey, func(a interface{}, b interface{}) bool { return byKey(b, a) }, WithInsertionOrder())
+	for _, r := range []record{{1, "alice"}, {2, "bob"}, {1, "carol"}, {1, "dave"}} {
+		tree.Insert(r)
+	}
+	tree.Delete(record{key: 1})
+	tree.Traverse(InOrder, func(value interface{}) {
+		fmt.Println(value)
+	})
+	// Output:
+	// {1 carol}
+	// {1 dave}
+	// {2 bob}
+}
+
+// Sort records by key, keeping ties in their original order
+func ExampleSortRecords() {
+	records := []interface{}{
+		record{2, "carol"}, record{1, "alice"}, record{2, "bob"}, record{1, "dave"},
+	}
+	sorted := SortRecords(records, func(a interface{}, b interface{}) bool {
+		return a.(record).key < b.(record).key
+	})
+	fmt.Println(sorted)
+	// Output:
+	// [{1 alice} {1 dave} {2 carol} {2 bob}]
+}
@@ -0,0 +1,97 @@
+package bstree
+
+import "sort"
+
+// ExistsAll checks for each of values if it exists in the tree
+// All lookups run under a single read lock. If values are sorted, they
+// descend the tree together: each node is compared only against the values
+// that reach it, so neighbouring values share their path from the root.
+// Average case time-complexity: O(len(values) * depth)
+// Worst case time-complexity: O(len(values) * size)
+func (tree *Tree) ExistsAll(values []interface{}) []bool {
+	defer tree.recoverPanic()
+	found := make([]bool, len(values))
+	sorted := true
+	for i, value := range values {
+		tree.counters.countLookup()
+		if tree.checkType(value) != nil {
+			return tree.existsEach(values, found)
+		}
+		if i > 0 && tree.smaller(value, values[i-1]) {
+			sorted = false
+		}
+	}
+	if !sorted {
+		return tree.existsEach(values, found)
+	}
+	pres := make([]uint64, len(values))
+	for i, value := range values {
+		pres[i] = tree.preKey(value)
+	}
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	tree.findSorted(tree.root, values, pres, 0, len(values), found)
+	tree.countMisses(found)
+	return found
+}
+
+// ExistsAny checks if any of values exists in the tree
+// All lookups run under a single read lock, stopping at the first value found.
+// Average case time-complexity: O(len(values) * depth)
+// Worst case time-complexity: O(len(values) * size)
+func (tree *Tree) ExistsAny(values []interface{}) bool {
+	defer tree.recoverPanic()
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	for _, value := range values {
+		tree.counters.countLookup()
+		if tree.checkType(value) == nil && tree.find(value) != nil {
+			return true
+		}
+		tree.counters.countMiss()
+	}
+	return false
+}
+
+// existsEach sets found[i] if values[i] exists, looking each value up on its own
+func (tree *Tree) existsEach(values []interface{}, found []bool) []bool {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	for i, value := range values {
+		found[i] = tree.checkType(value) == nil && tree.find(value) != nil
+	}
+	tree.countMisses(found)
+	return found
+}
+
+// countMisses counts the values of a batch lookup that were not found
+func (tree *Tree) countMisses(found []bool) {
+	if tree.counters == nil {
+		return
+	}
+	for _, ok := range found {
+		if !ok {
+			tree.counters.countMiss()
+		}
+	}
+}
+
+// findSorted sets found[i] for each of the sorted values[lo:hi] that exists in the subtree
+// pres holds the pre-keys of values.
+func (tree *Tree) findSorted(node *_Node, values []interface{}, pres []uint64, lo int, hi int, found []bool) {
+	if node == nil || lo == hi {
+		return
+	}
+	// values[lo:middle] are smaller than node, values[middle:end] are equal to it
+	middle := lo + sort.Search(hi-lo, func(i int) bool {
+		return tree.order(values[lo+i], pres[lo+i], node) >= 0
+	})
+	end := middle + sort.Search(hi-middle, func(i int) bool {
+		return tree.order(values[middle+i], pres[middle+i], node) > 0
+	})
+	for i := middle; i < end; i++ {
+		found[i] = true
+	}
+	tree.findSorted(node.left, values, pres, lo, middle, found)
+	tree.findSorted(node.right(), values, pres, end, hi, found)
+}
@@ -0,0 +1,60 @@
+package bstree
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestTree_ExistsAll(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend, WithType(reflect.TypeOf(0)))
+		for _, value := range Values(RandomTree(500, 2000)) {
+			tree.Insert(value)
+		}
+		var values []interface{}
+		for _, value := range rand.Perm(2000) {
+			values = append(values, value)
+		}
+		check := func(name string, values []interface{}) {
+			found := tree.ExistsAll(values)
+			for i, value := range values {
+				if expected := tree.Exists(value); expected != found[i] {
+					t.Errorf("%s(%v): {Expected: %t | Actual: %t}", name, value, expected, found[i])
+				}
+			}
+		}
+		check("Unsorted", values)
+		sort.Slice(values, func(i, j int) bool { return values[i].(int) < values[j].(int) })
+		check("Sorted", values)
+		check("Mistyped", append(values[:10:10], "ten"))
+	})
+}
+
+func TestTree_ExistsAny(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithType(reflect.TypeOf(0)))
+	for i := 1; i <= 10; i++ {
+		tree.Insert(i)
+	}
+	if !tree.ExistsAny([]interface{}{0, "one", 10}) {
+		t.Errorf("ExistsAny(0, one, 10): {Expected: true | Actual: false}")
+	}
+	if tree.ExistsAny([]interface{}{0, 11}) {
+		t.Errorf("ExistsAny(0, 11): {Expected: false | Actual: true}")
+	}
+	if tree.ExistsAny(nil) {
+		t.Errorf("ExistsAny(): {Expected: false | Actual: true}")
+	}
+}
+
+// Look up a batch of values at once
+func ExampleTree_ExistsAll() {
+	tree := CompleteTree(10)
+	fmt.Println(tree.ExistsAll([]interface{}{0, 3, 7, 12}))
+	fmt.Println(tree.ExistsAny([]interface{}{0, 12}))
+	// Output:
+	// [false true true false]
+	// false
+}
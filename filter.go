@@ -0,0 +1,60 @@
+package bstree
+
+// Filter returns a new tree holding the values for which pred returns true
+// The new tree shares the comparators and the value settings of the tree
+// (key, type, duplicates, balancing, augment and formatting), but not its
+// write-ahead log, hooks, allocator or read snapshot.
+// It is bulk-built balanced from the matching values in order.
+// Time-complexity: O(size)
+func (tree *Tree) Filter(pred func(value interface{}) bool) *Tree {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	var matching []interface{}
+	tree.doInOrder(tree.root, func(value interface{}) {
+		if pred(value) {
+			matching = append(matching, value)
+		}
+	})
+	filtered := tree.sibling()
+	filtered.mutex.Lock()
+	filtered.load(matching)
+	filtered.mutex.Unlock()
+	return filtered
+}
+
+// sibling creates an empty tree ordering and validating values like the tree
+func (tree *Tree) sibling() *Tree {
+	sibling := &Tree{
+		smaller:       tree.smaller,
+		larger:        tree.larger,
+		compare:       tree.compare,
+		encoder:       tree.encoder,
+		stringer:      tree.stringer,
+		key:           tree.key,
+		keySmaller:    tree.keySmaller,
+		keyLarger:     tree.keyLarger,
+		augment:       tree.augment,
+		duplicates:    tree.duplicates,
+		parents:       tree.parents,
+		threads:       tree.threads,
+		fifo:          tree.fifo,
+		balancing:     tree.balancing,
+		autoRebalance: tree.autoRebalance,
+		assertions:    tree.assertions,
+		valueType:     tree.valueType,
+		nilPolicy:     tree.nilPolicy,
+		sizer:         tree.sizer,
+		keyCache:      tree.keyCache,
+		merkle:        tree.merkle,
+		copyBytes:     tree.copyBytes,
+		opTimeout:     tree.opTimeout,
+		recovery:      tree.recovery,
+	}
+	if tree.buffer != nil {
+		WithInsertBuffer(tree.buffer.capacity)(sibling)
+	}
+	if tree.bloom != nil {
+		WithBloomFilter(tree.bloom.bitsPerKey, tree.bloom.hash)(sibling)
+	}
+	return sibling
+}
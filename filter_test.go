@@ -0,0 +1,48 @@
+package bstree
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestTree_Filter(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend, WithType(reflect.TypeOf(0)))
+		for _, value := range Values(RandomTree(1000, 5000)) {
+			tree.Insert(value)
+		}
+		even := tree.Filter(func(value interface{}) bool {
+			return value.(int)%2 == 0
+		})
+		mustCheck(t, even)
+		expected := 0
+		tree.Traverse(InOrder, func(value interface{}) {
+			if value.(int)%2 == 0 {
+				expected++
+				if !even.Exists(value) {
+					t.Errorf("Exists(%v): {Expected: true | Actual: false}", value)
+				}
+			}
+		})
+		if expected != even.Size() {
+			t.Errorf("Size: {Expected: %d | Actual: %d}", expected, even.Size())
+		}
+		if even.Insert("odd") {
+			t.Errorf("Insert(\"odd\"): {Expected: false | Actual: true}")
+		}
+	})
+}
+
+// Keep the values matching a predicate in a new tree
+func ExampleTree_Filter() {
+	tree := CompleteTree(10)
+	small := tree.Filter(func(value interface{}) bool {
+		return value.(int) <= 3
+	})
+	fmt.Println(small)
+	fmt.Println(tree.Size())
+	// Output:
+	// {size: 3 | depth: 2 | min: 1 | max: 3 | values: [1 2 3]}
+	// 10
+}
@@ -0,0 +1,16 @@
+package bstree
+
+// Fold combines the values of the tree into a single result
+// The values are visited using the specified traversal algorithm, starting
+// with init as the accumulator; f returns the accumulator for the next value.
+// Time-complexity: O(size)
+func (tree *Tree) Fold(traversal Traversal, init interface{}, f func(acc interface{}, value interface{}) interface{}) interface{} {
+	defer tree.recoverPanic()
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	acc := init
+	tree.doTraverse(traversal, func(value interface{}) {
+		acc = f(acc, value)
+	})
+	return acc
+}
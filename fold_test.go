@@ -0,0 +1,30 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_Fold(t *testing.T) {
+	tree := CompleteTree(100)
+	sum := tree.Fold(InOrder, 0, func(acc interface{}, value interface{}) interface{} {
+		return acc.(int) + value.(int)
+	})
+	if expected := 5050; expected != sum {
+		t.Errorf("Sum: {Expected: %d | Actual: %v}", expected, sum)
+	}
+	if expected := 7; expected != EmptyTree().Fold(InOrder, 7, nil) {
+		t.Errorf("Empty: {Expected: %d | Actual: %v}", expected, EmptyTree().Fold(InOrder, 7, nil))
+	}
+}
+
+// Concatenate the values in pre-order
+func ExampleTree_Fold() {
+	tree := CompleteTree(7)
+	joined := tree.Fold(PreOrder, "", func(acc interface{}, value interface{}) interface{} {
+		return fmt.Sprintf("%s%d", acc, value)
+	})
+	fmt.Println(joined)
+	// Output:
+	// 4213657
+}
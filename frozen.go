@@ -0,0 +1,93 @@
+package bstree
+
+// Frozen is an immutable, read-optimized copy of a tree
+// The values are laid out in a single slice in Eytzinger (BFS) order,
+// so lookups walk a contiguous array instead of chasing pointers.
+// A Frozen tree needs no locking and is safe for use by concurrent goroutines.
+type Frozen struct {
+	values  []interface{} // 1-indexed: the children of i are 2i and 2i+1
+	smaller Smaller
+	larger  Larger
+}
+
+// Freeze creates a read-optimized copy of the tree
+// Time-complexity: O(size)
+func (tree *Tree) Freeze() *Frozen {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	return tree.freeze()
+}
+
+func (tree *Tree) freeze() *Frozen {
+	tree.assertLocked()
+	frozen := &Frozen{
+		values:  make([]interface{}, tree.size+1),
+		smaller: tree.smaller,
+		larger:  tree.larger,
+	}
+	frozen.doFreeze(tree.values(), new(int), 1)
+	return frozen
+}
+
+// doFreeze places the sorted values at their Eytzinger positions by in-order walking the implicit tree
+func (frozen *Frozen) doFreeze(sorted []interface{}, next *int, index int) {
+	if index >= len(frozen.values) {
+		return
+	}
+	frozen.doFreeze(sorted, next, 2*index)
+	frozen.values[index] = sorted[*next]
+	*next++
+	frozen.doFreeze(sorted, next, 2*index+1)
+}
+
+// Thaw creates a regular, balanced tree from the frozen values
+// Time-complexity: O(size)
+func (frozen *Frozen) Thaw(options ...Option) *Tree {
+	tree := New(frozen.smaller, frozen.larger, options...)
+	sorted := make([]interface{}, 0, frozen.Size())
+	frozen.doInOrder(1, func(value interface{}) {
+		sorted = append(sorted, tree.own(value))
+	})
+	tree.mutex.Lock()
+	tree.load(sorted)
+	tree.mutex.Unlock()
+	return tree
+}
+
+func (frozen *Frozen) doInOrder(index int, visitor Visitor) {
+	if index >= len(frozen.values) {
+		return
+	}
+	frozen.doInOrder(2*index, visitor)
+	visitor(frozen.values[index])
+	frozen.doInOrder(2*index+1, visitor)
+}
+
+// Size returns the number of values in the frozen tree
+// Time-complexity: O(1)
+func (frozen *Frozen) Size() int {
+	return len(frozen.values) - 1
+}
+
+// Exists checks if a value exists in the frozen tree
+// Time-complexity: O(log(size))
+func (frozen *Frozen) Exists(value interface{}) bool {
+	index := 1
+	for index < len(frozen.values) {
+		switch {
+		case frozen.smaller(value, frozen.values[index]):
+			index = 2 * index
+		case frozen.larger(value, frozen.values[index]):
+			index = 2*index + 1
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Traverse calls visitor on each value of the frozen tree in sorted order
+// Time-complexity: O(size)
+func (frozen *Frozen) Traverse(visitor Visitor) {
+	frozen.doInOrder(1, visitor)
+}
@@ -0,0 +1,61 @@
+package bstree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestFrozen_Exists(t *testing.T) {
+	for count := 0; count <= 100; count++ {
+		tree := RandomTree(count, 200)
+		frozen := tree.Freeze()
+		if count != frozen.Size() {
+			t.Errorf("Size: {Expected: %d | Actual: %d}", count, frozen.Size())
+		}
+		for i := -1; i <= 200; i++ {
+			if tree.Exists(i) != frozen.Exists(i) {
+				t.Errorf("Exists(%d): {Expected: %t | Actual: %t}", i, tree.Exists(i), frozen.Exists(i))
+			}
+		}
+	}
+}
+
+func TestFrozen_Thaw(t *testing.T) {
+	tree := RandomTree(1000, 5000)
+	thawed := tree.Freeze().Thaw()
+	if tree.Size() != thawed.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", tree.Size(), thawed.Size())
+	}
+	if expected := 10; expected != thawed.Depth() {
+		t.Errorf("Depth: {Expected: %d | Actual: %d}", expected, thawed.Depth())
+	}
+	tree.Traverse(InOrder, func(value interface{}) {
+		if !thawed.Exists(value) {
+			t.Errorf("Exists(%d): {Expected: true | Actual: false}", value)
+		}
+	})
+}
+
+// Freeze a tree and iterate it in sorted order
+func ExampleFrozen_Traverse() {
+	frozen := CompleteTree(7).Freeze()
+	frozen.Traverse(func(value interface{}) {
+		fmt.Printf("%d,", value)
+	})
+	fmt.Printf("\n")
+	fmt.Println(frozen.Exists(4), frozen.Exists(8))
+	// Output:
+	// 1,2,3,4,5,6,7,
+	// true false
+}
+
+// Benchmark lookups on a frozen tree
+func BenchmarkFrozenExists(b *testing.B) {
+	b.StopTimer()
+	frozen := RandomTree(100000, 1000000).Freeze()
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		frozen.Exists(rand.Intn(1000000))
+	}
+}
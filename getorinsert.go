@@ -0,0 +1,28 @@
+package bstree
+
+// GetOrInsert returns the stored value equal to value, or inserts value if there is none
+// inserted reports whether value was inserted, in which case existing is
+// value itself. The lookup and the insert happen under a single write
+// lock, so concurrent callers never insert the same value twice. Trees
+// created WithDuplicates also return an existing value instead of inserting.
+// Values rejected by WithType or WithNilPolicy return (nil, false).
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (tree *Tree) GetOrInsert(value interface{}) (existing interface{}, inserted bool) {
+	defer tree.recoverPanic()
+	if tree.checkType(value) != nil {
+		return nil, false
+	}
+	value = tree.own(value)
+	if tree.lockOp("GetOrInsert") != nil {
+		return nil, false
+	}
+	defer tree.mutex.Unlock()
+	if node := tree.find(value); node != nil {
+		return node.value, false
+	}
+	tree.insert(value)
+	tree.wal.log(_WALInsert, value)
+	tree.hooks.fireInsert(value)
+	return value, true
+}
@@ -0,0 +1,42 @@
+package bstree
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestTree_GetOrInsert(t *testing.T) {
+	tree := EmptyTree()
+	var inserts atomic.Int64
+	var wg sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				if _, inserted := tree.GetOrInsert(i); inserted {
+					inserts.Add(1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if expected := int64(100); expected != inserts.Load() {
+		t.Errorf("Inserts: {Expected: %d | Actual: %d}", expected, inserts.Load())
+	}
+	if expected := 100; expected != tree.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+	}
+}
+
+// Deduplicate records by key, keeping the first one seen
+func ExampleTree_GetOrInsert() {
+	tree := NewByKey(userID, IntSmaller, IntLarger)
+	fmt.Println(tree.GetOrInsert(user{1, "alice"}))
+	fmt.Println(tree.GetOrInsert(user{1, "bob"}))
+	// Output:
+	// {1 alice} true
+	// {1 alice} false
+}
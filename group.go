@@ -0,0 +1,25 @@
+package bstree
+
+// GroupBy distributes the values of the tree into one new tree per group
+// keyFn names the group of each value. The trees share the settings of the
+// tree like with Filter, and are bulk-built balanced after a single
+// in-order pass. Groups without values are not in the map.
+// Time-complexity: O(size)
+func (tree *Tree) GroupBy(keyFn func(value interface{}) string) map[string]*Tree {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	groups := make(map[string][]interface{})
+	tree.doInOrder(tree.root, func(value interface{}) {
+		key := keyFn(value)
+		groups[key] = append(groups[key], value)
+	})
+	trees := make(map[string]*Tree, len(groups))
+	for key, values := range groups {
+		group := tree.sibling()
+		group.mutex.Lock()
+		group.load(values)
+		group.mutex.Unlock()
+		trees[key] = group
+	}
+	return trees
+}
@@ -0,0 +1,48 @@
+package bstree
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func TestTree_GroupBy(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend)
+		for _, value := range Values(RandomTree(1000, 5000)) {
+			tree.Insert(value)
+		}
+		groups := tree.GroupBy(func(value interface{}) string {
+			return strconv.Itoa(value.(int) % 7)
+		})
+		total := 0
+		for key, group := range groups {
+			mustCheck(t, group)
+			total += group.Size()
+			group.Traverse(InOrder, func(value interface{}) {
+				if actual := strconv.Itoa(value.(int) % 7); actual != key {
+					t.Errorf("Group of %v: {Expected: %s | Actual: %s}", value, key, actual)
+				}
+			})
+		}
+		if expected := tree.Size(); expected != total {
+			t.Errorf("Size: {Expected: %d | Actual: %d}", expected, total)
+		}
+	})
+}
+
+// Split a tree into one tree per group
+func ExampleTree_GroupBy() {
+	tree := CompleteTree(10)
+	groups := tree.GroupBy(func(value interface{}) string {
+		if value.(int)%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	})
+	fmt.Println(groups["even"])
+	fmt.Println(groups["odd"])
+	// Output:
+	// {size: 5 | depth: 3 | min: 2 | max: 10 | values: [2 4 6 8 10]}
+	// {size: 5 | depth: 3 | min: 1 | max: 9 | values: [1 3 5 7 9]}
+}
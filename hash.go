@@ -0,0 +1,26 @@
+package bstree
+
+import (
+	"encoding/binary"
+	"hash"
+)
+
+// Hash computes a digest of the values of the tree in sorted order
+// Each value is converted to bytes by encode and written to h prefixed
+// by its length, so trees holding different values, or the same values
+// split differently, don't collide by concatenation. Trees with equal
+// contents have equal digests regardless of their shape, which lets
+// replicas cheaply verify that they hold identical sets. h is reset first.
+// Time-complexity: O(size)
+func (tree *Tree) Hash(h hash.Hash, encode func(value interface{}) []byte) []byte {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	h.Reset()
+	var length [binary.MaxVarintLen64]byte
+	tree.doInOrder(tree.root, func(value interface{}) {
+		data := encode(value)
+		h.Write(length[:binary.PutUvarint(length[:], uint64(len(data)))])
+		h.Write(data)
+	})
+	return h.Sum(nil)
+}
@@ -0,0 +1,51 @@
+package bstree
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func encodeInt(value interface{}) []byte {
+	return []byte(strconv.Itoa(value.(int)))
+}
+
+func TestTree_Hash(t *testing.T) {
+	unbalanced, balanced := New(IntSmaller, IntLarger), New(IntSmaller, IntLarger, WithBalancing(LLRB))
+	for i := 0; i < 100; i++ {
+		unbalanced.Insert(i)
+		balanced.Insert(99 - i)
+	}
+	expected := unbalanced.Hash(sha256.New(), encodeInt)
+	if actual := balanced.Hash(sha256.New(), encodeInt); !bytes.Equal(expected, actual) {
+		t.Errorf("Hash of equal contents: {Expected: %x | Actual: %x}", expected, actual)
+	}
+	balanced.Delete(50)
+	if actual := balanced.Hash(sha256.New(), encodeInt); bytes.Equal(expected, actual) {
+		t.Errorf("Hash of different contents: {Expected: != %x | Actual: %x}", expected, actual)
+	}
+	// 1,23 and 12,3 concatenate to the same bytes
+	split := New(IntSmaller, IntLarger)
+	split.Insert(1)
+	split.Insert(23)
+	other := New(IntSmaller, IntLarger)
+	other.Insert(12)
+	other.Insert(3)
+	if bytes.Equal(split.Hash(sha256.New(), encodeInt), other.Hash(sha256.New(), encodeInt)) {
+		t.Errorf("Hash of {1, 23} and {3, 12}: {Expected: different | Actual: equal}")
+	}
+}
+
+// Compare the contents of two replicas
+func ExampleTree_Hash() {
+	replica, other := New(IntSmaller, IntLarger), New(IntSmaller, IntLarger)
+	for _, value := range []int{3, 1, 2} {
+		replica.Insert(value)
+		other.Insert(4 - value)
+	}
+	fmt.Println(bytes.Equal(replica.Hash(sha256.New(), encodeInt), other.Hash(sha256.New(), encodeInt)))
+	// Output:
+	// true
+}
@@ -0,0 +1,42 @@
+package bstree
+
+// Hook is called with a value that was inserted into or deleted from a tree
+type Hook func(value interface{})
+
+// _Hooks holds the change notification hooks registered on a tree
+type _Hooks struct {
+	insert []Hook
+	delete []Hook
+}
+
+func (hooks *_Hooks) fireInsert(value interface{}) {
+	for _, hook := range hooks.insert {
+		hook(value)
+	}
+}
+
+func (hooks *_Hooks) fireDelete(value interface{}) {
+	for _, hook := range hooks.delete {
+		hook(value)
+	}
+}
+
+// OnInsert registers a hook that is called after every successful insert
+// Hooks are called in registration order while the tree is still locked,
+// so they observe changes in the order they were applied. A hook must
+// therefore not call back into the tree.
+// Time-complexity: O(1)
+func (tree *Tree) OnInsert(hook Hook) {
+	tree.mutex.Lock()
+	defer tree.mutex.Unlock()
+	tree.hooks.insert = append(tree.hooks.insert, hook)
+}
+
+// OnDelete registers a hook that is called after every successful delete
+// The same rules as for OnInsert apply.
+// Time-complexity: O(1)
+func (tree *Tree) OnDelete(hook Hook) {
+	tree.mutex.Lock()
+	defer tree.mutex.Unlock()
+	tree.hooks.delete = append(tree.hooks.delete, hook)
+}
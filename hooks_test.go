@@ -0,0 +1,45 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_Hooks(t *testing.T) {
+	tree := EmptyTree()
+	mirror := EmptyTree()
+	tree.OnInsert(func(value interface{}) { mirror.Insert(value) })
+	tree.OnDelete(func(value interface{}) { mirror.Delete(value) })
+	for i := 0; i < 100; i++ {
+		tree.Insert(i % 60)
+	}
+	for i := 0; i < 100; i += 7 {
+		tree.Delete(i)
+	}
+	if tree.Size() != mirror.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", tree.Size(), mirror.Size())
+	}
+	tree.Traverse(InOrder, func(value interface{}) {
+		if !mirror.Exists(value) {
+			t.Errorf("Exists(%d): {Expected: true | Actual: false}", value)
+		}
+	})
+}
+
+// Get notified about changes to the tree
+func ExampleTree_OnInsert() {
+	tree := EmptyTree()
+	tree.OnInsert(func(value interface{}) {
+		fmt.Println("inserted", value)
+	})
+	tree.OnDelete(func(value interface{}) {
+		fmt.Println("deleted", value)
+	})
+	tree.Insert(1)
+	tree.Insert(1)
+	tree.Delete(1)
+	tree.Delete(2)
+	// Output:
+	// inserted 1
+	// deleted 1
+}
@@ -0,0 +1,158 @@
+package bstree
+
+import "sync"
+
+// Index describes a secondary key of the values in an IndexedSet
+// Key extracts the secondary key from a value; Smaller and Larger compare
+// keys. Unlike primary values, secondary keys don't need to be unique.
+type Index struct {
+	Name    string
+	Key     func(value interface{}) interface{}
+	Smaller Smaller
+	Larger  Larger
+}
+
+// IndexedSet keeps one primary tree and a secondary tree per index in sync
+// Values are unique by the primary ordering and can be found by any of
+// their secondary keys as well. Insert and Delete update all trees under
+// one lock, so lookups never see a value in one tree but not in another.
+type IndexedSet struct {
+	mutex     sync.RWMutex
+	primary   *Tree
+	secondary map[string]*Tree
+}
+
+// NewIndexedSet creates an empty set ordered by smaller and larger, with the given secondary indexes
+// The options configure every tree of the set, so they must not change
+// which values count as equal, as WithDuplicates would.
+// Time-complexity: O(len(indexes))
+func NewIndexedSet(smaller Smaller, larger Larger, indexes []Index, options ...Option) *IndexedSet {
+	set := &IndexedSet{primary: New(smaller, larger, options...), secondary: make(map[string]*Tree, len(indexes))}
+	for _, index := range indexes {
+		set.secondary[index.Name] = newIndexTree(index, smaller, larger, options...)
+	}
+	return set
+}
+
+// newIndexTree creates a tree ordering values by the key of index, then by their primary order
+// Breaking ties by the primary order keeps values with equal keys apart,
+// so a specific one can be deleted again.
+func newIndexTree(index Index, smaller Smaller, larger Larger, options ...Option) *Tree {
+	key, keySmaller, keyLarger := index.Key, index.Smaller, index.Larger
+	tree := New(func(value interface{}, other interface{}) bool {
+		a, b := key(value), key(other)
+		return keySmaller(a, b) || (!keyLarger(a, b) && smaller(value, other))
+	}, func(value interface{}, other interface{}) bool {
+		a, b := key(value), key(other)
+		return keyLarger(a, b) || (!keySmaller(a, b) && larger(value, other))
+	}, options...)
+	tree.key = key
+	tree.keySmaller = keySmaller
+	tree.keyLarger = keyLarger
+	return tree
+}
+
+// Primary returns the tree ordering the values by the primary ordering
+// It must not be modified directly, or the indexes get out of sync.
+// Time-complexity: O(1)
+func (set *IndexedSet) Primary() *Tree {
+	return set.primary
+}
+
+// Index returns the tree ordering the values by the named secondary key, or nil if there is no such index
+// It must not be modified directly, or the indexes get out of sync.
+// Time-complexity: O(1)
+func (set *IndexedSet) Index(name string) *Tree {
+	return set.secondary[name]
+}
+
+// Insert adds value to the primary tree and all indexes if it doesn't already exist
+// Average case time-complexity: O(depth * (1 + indexes))
+func (set *IndexedSet) Insert(value interface{}) bool {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	if !set.primary.Insert(value) {
+		return false
+	}
+	for _, tree := range set.secondary {
+		tree.Insert(value)
+	}
+	return true
+}
+
+// Delete removes the value equal to value from the primary tree and all indexes
+// value only needs the fields the primary ordering looks at; the stored
+// value provides the secondary keys.
+// Average case time-complexity: O(depth * (1 + indexes))
+func (set *IndexedSet) Delete(value interface{}) bool {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	stored, ok := set.primary.GetByKey(value)
+	if !ok {
+		return false
+	}
+	set.primary.Delete(stored)
+	for _, tree := range set.secondary {
+		tree.Delete(stored)
+	}
+	return true
+}
+
+// Get returns the stored value equal to value by the primary ordering
+// Average case time-complexity: O(depth)
+func (set *IndexedSet) Get(value interface{}) (interface{}, bool) {
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+	return set.primary.GetByKey(value)
+}
+
+// Exists checks if a value equal to value by the primary ordering exists
+// Average case time-complexity: O(depth)
+func (set *IndexedSet) Exists(value interface{}) bool {
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+	return set.primary.Exists(value)
+}
+
+// Lookup returns all values whose key in the named index equals key, in primary order
+// It returns nil if there is no such index.
+// Average case time-complexity: O(depth + matches)
+func (set *IndexedSet) Lookup(name string, key interface{}) []interface{} {
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+	tree := set.secondary[name]
+	if tree == nil {
+		return nil
+	}
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	var values []interface{}
+	tree.collectKey(tree.root, key, func(value interface{}) {
+		values = append(values, value)
+	})
+	return values
+}
+
+// collectKey visits the values with the given key in order, skipping subtrees that can't hold any
+func (tree *Tree) collectKey(node *_Node, key interface{}, visitor func(value interface{})) {
+	for node != nil {
+		switch other := tree.key(node.value); {
+		case tree.keySmaller(key, other):
+			node = node.left
+		case tree.keyLarger(key, other):
+			node = node.right()
+		default:
+			tree.collectKey(node.left, key, visitor)
+			visitor(node.value)
+			node = node.right()
+		}
+	}
+}
+
+// Size returns the number of values in the set
+// Time-complexity: O(1)
+func (set *IndexedSet) Size() int {
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+	return set.primary.Size()
+}
@@ -0,0 +1,86 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+type employee struct {
+	id   int
+	name string
+	team string
+}
+
+func employeeID(value interface{}) interface{} {
+	return value.(employee).id
+}
+
+var employeeIndexes = []Index{
+	{Name: "name", Key: func(value interface{}) interface{} { return value.(employee).name }, Smaller: OrderedSmaller[string], Larger: OrderedLarger[string]},
+	{Name: "team", Key: func(value interface{}) interface{} { return value.(employee).team }, Smaller: OrderedSmaller[string], Larger: OrderedLarger[string]},
+}
+
+func newEmployeeSet(options ...Option) *IndexedSet {
+	byID := func(value interface{}, other interface{}) bool { return value.(employee).id < other.(employee).id }
+	return NewIndexedSet(byID, func(value interface{}, other interface{}) bool { return byID(other, value) }, employeeIndexes, options...)
+}
+
+func TestIndexedSet(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		set := newEmployeeSet(backend)
+		teams := []string{"red", "green", "blue"}
+		for i := 0; i < 300; i++ {
+			if !set.Insert(employee{i, fmt.Sprintf("e%03d", i), teams[i%3]}) {
+				t.Errorf("Insert(%d): {Expected: true | Actual: false}", i)
+			}
+		}
+		if set.Insert(employee{7, "other", "red"}) {
+			t.Errorf("Insert(7): {Expected: false | Actual: true}")
+		}
+		for i := 0; i < 300; i += 2 {
+			if !set.Delete(employee{id: i}) {
+				t.Errorf("Delete(%d): {Expected: true | Actual: false}", i)
+			}
+		}
+		for _, tree := range []*Tree{set.Primary(), set.Index("name"), set.Index("team")} {
+			mustCheck(t, tree)
+			if expected := 150; expected != tree.Size() {
+				t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+			}
+		}
+		red := set.Lookup("team", "red")
+		if expected := 50; expected != len(red) {
+			t.Fatalf("Lookup(team, red): {Expected: %d values | Actual: %d values}", expected, len(red))
+		}
+		for i, value := range red {
+			if expected := 3 + 6*i; expected != value.(employee).id {
+				t.Errorf("Lookup(team, red)[%d]: {Expected: %d | Actual: %d}", i, expected, value.(employee).id)
+			}
+		}
+		if found := set.Lookup("name", "e010"); len(found) != 0 {
+			t.Errorf("Lookup(name, e010): {Expected: [] | Actual: %v}", found)
+		}
+		if found := set.Lookup("name", "e011"); len(found) != 1 || found[0].(employee).id != 11 {
+			t.Errorf("Lookup(name, e011): {Expected: [{11 e011 green}] | Actual: %v}", found)
+		}
+		if found := set.Lookup("missing", "e011"); found != nil {
+			t.Errorf("Lookup(missing, e011): {Expected: [] | Actual: %v}", found)
+		}
+	})
+}
+
+// Find employees by id and by team
+func ExampleIndexedSet() {
+	set := newEmployeeSet()
+	set.Insert(employee{3, "carol", "red"})
+	set.Insert(employee{1, "alice", "blue"})
+	set.Insert(employee{2, "bob", "red"})
+	fmt.Println(set.Get(employee{id: 1}))
+	fmt.Println(set.Lookup("team", "red"))
+	set.Delete(employee{id: 3})
+	fmt.Println(set.Lookup("team", "red"))
+	// Output:
+	// {1 alice blue} true
+	// [{2 bob red} {3 carol red}]
+	// [{2 bob red}]
+}
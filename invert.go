@@ -0,0 +1,57 @@
+package bstree
+
+// ReverseComparators inverts the order of a pair of comparators, e.g. for descending trees
+func ReverseComparators(smaller Smaller, larger Larger) (Smaller, Larger) {
+	return Smaller(larger), Larger(smaller)
+}
+
+// Invert returns the mirror image of the tree, which holds the same values in descending order
+// The copy is configured like the tree, see Filter, but with reversed
+// comparators. Unbalanced trees are mirrored node by node, so the copy has
+// the mirrored shape without comparing any values. LLRB trees can't be
+// mirrored, as their red links would lean right, and are rebuilt from the
+// reversed values instead. Pre-keys from WithKeyCache don't carry over.
+// Time-complexity: O(size)
+func (tree *Tree) Invert() *Tree {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	inverted := tree.sibling()
+	inverted.smaller, inverted.larger = ReverseComparators(tree.smaller, tree.larger)
+	inverted.compare = Reversed(tree.compare)
+	if tree.key != nil {
+		inverted.keySmaller, inverted.keyLarger = ReverseComparators(tree.keySmaller, tree.keyLarger)
+	}
+	inverted.keyCache = nil
+	inverted.mutex.Lock()
+	defer inverted.mutex.Unlock()
+	if tree.balancing == LLRB {
+		values := tree.values()
+		for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+			values[i], values[j] = values[j], values[i]
+		}
+		inverted.load(values)
+		return inverted
+	}
+	inverted.root = inverted.mirrorNodes(tree.root, nil)
+	inverted.orphanRoot()
+	inverted.size = tree.size
+	if inverted.bloom.full() {
+		inverted.rebuildBloom()
+	}
+	inverted.refreshExtremes()
+	inverted.verify()
+	return inverted
+}
+
+// mirrorNodes copies the subtree rooted at node, which belongs to another tree, with left and right swapped
+// next is the in-order successor of the copy, see doInsert.
+func (tree *Tree) mirrorNodes(node *_Node, next *_Node) *_Node {
+	if node == nil {
+		return nil
+	}
+	mirrored := tree.allocNode(node.value)
+	mirrored.left = tree.mirrorNodes(node.right(), mirrored)
+	tree.setRight(mirrored, tree.mirrorNodes(node.left, next), next)
+	tree.update(mirrored)
+	return mirrored
+}
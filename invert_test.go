@@ -0,0 +1,46 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_Invert(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend)
+		for i := 0; i < 100; i++ {
+			tree.Insert((i * 37) % 100)
+		}
+		inverted := tree.Invert()
+		mustCheck(t, inverted)
+		values, reversed := Values(tree), Values(inverted)
+		if len(values) != len(reversed) {
+			t.Fatalf("Size: {Expected: %d | Actual: %d}", len(values), len(reversed))
+		}
+		for i := range values {
+			if values[i] != reversed[len(reversed)-1-i] {
+				t.Fatalf("Values: {Expected: reverse of %v | Actual: %v}", values, reversed)
+			}
+		}
+		if inverted.Minimum() != 99 || inverted.Maximum() != 0 {
+			t.Errorf("Minimum, Maximum: {Expected: 99 0 | Actual: %v %v}", inverted.Minimum(), inverted.Maximum())
+		}
+		if expected := tree.Depth(); tree.balancing == Unbalanced && expected != inverted.Depth() {
+			t.Errorf("Depth: {Expected: %d | Actual: %d}", expected, inverted.Depth())
+		}
+		inverted.Insert(-1)
+		inverted.Delete(50)
+		mustCheck(t, inverted)
+		if tree.Exists(-1) || !tree.Exists(50) {
+			t.Errorf("Tree after modifying the inverted copy: {Expected: unchanged | Actual: changed}")
+		}
+	})
+}
+
+// Create a descending tree from an ascending one
+func ExampleTree_Invert() {
+	tree := CompleteTree(5)
+	fmt.Println(tree.Invert())
+	// Output:
+	// {size: 5 | depth: 3 | min: 5 | max: 1 | values: [5 4 3 2 1]}
+}
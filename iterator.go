@@ -0,0 +1,142 @@
+package bstree
+
+// Iterator walks values in ascending order
+// Call Next before every Value, including the first:
+//
+//	for it := tree.Iterator(); it.Next(); {
+//		fmt.Println(it.Value())
+//	}
+type Iterator interface {
+	// Next advances to the next value and reports whether there is one
+	Next() bool
+	// Value returns the current value
+	Value() interface{}
+}
+
+// _TreeIterator iterates over a tree without holding its lock between steps
+type _TreeIterator struct {
+	tree    *Tree
+	stack   []*_Node // path of nodes whose value has not been visited yet
+	node    *_Node   // current node, used instead of the stack with threads or parent pointers
+	version uint64   // version of the tree the stack belongs to
+	value   interface{}
+	started bool
+	visited bool // value holds a returned value, as opposed to running out before the first
+}
+
+// Iterator returns an iterator over the values of the tree in ascending order
+// The lock is only held during each call to Next, so the tree may be
+// modified while iterating. When that happens the iterator notices the
+// new version and continues with the smallest value larger than the
+// current one, or with the smallest value if it had run out before
+// returning any, so values are never repeated or returned out of order.
+// Values inserted behind the current position are not visited.
+// Time-complexity: O(1) per step on average, O(depth) after a modification
+func (tree *Tree) Iterator() Iterator {
+	return &_TreeIterator{tree: tree}
+}
+
+// Version returns the modification counter of the tree
+// It is incremented by every successful modification.
+// Time-complexity: O(1)
+func (tree *Tree) Version() uint64 {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	return tree.version
+}
+
+func (it *_TreeIterator) Next() bool {
+	tree := it.tree
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	if tree.parents || tree.threads {
+		return it.step()
+	}
+	switch {
+	case !it.started, it.version != tree.version && !it.visited:
+		// Without a current value, every value comes after the position
+		it.started = true
+		it.version = tree.version
+		it.stack = it.stack[:0]
+		it.pushLeft(tree.root)
+	case it.version != tree.version:
+		it.version = tree.version
+		it.seekAfter(it.value)
+	}
+	if len(it.stack) == 0 {
+		return false
+	}
+	node := it.stack[len(it.stack)-1]
+	it.stack = it.stack[:len(it.stack)-1]
+	it.pushLeft(node.right())
+	it.value = node.value
+	it.visited = true
+	return true
+}
+
+func (it *_TreeIterator) Value() interface{} {
+	return it.value
+}
+
+// pushLeft pushes node and its chain of left children
+func (it *_TreeIterator) pushLeft(node *_Node) {
+	for node != nil {
+		it.stack = append(it.stack, node)
+		node = node.left
+	}
+}
+
+// seekAfter rebuilds the stack so that the next value is the smallest one larger than value
+func (it *_TreeIterator) seekAfter(value interface{}) {
+	it.stack = it.stack[:0]
+	node := it.tree.root
+	for node != nil {
+		if it.tree.larger(node.value, value) {
+			it.stack = append(it.stack, node)
+			node = node.left
+		} else {
+			node = node.right()
+		}
+	}
+}
+
+// step advances to the successor of the current node by following threads or parent pointers
+func (it *_TreeIterator) step() bool {
+	tree := it.tree
+	switch {
+	case !it.started, it.version != tree.version && !it.visited:
+		it.started = true
+		it.node = nil
+		if tree.root != nil {
+			it.node = leftmost(tree.root)
+		}
+	case it.version != tree.version:
+		it.node = tree.above(it.value)
+	case it.node != nil && tree.threads:
+		it.node = nextInOrder(it.node)
+	case it.node != nil:
+		it.node = successor(it.node)
+	}
+	it.version = tree.version
+	if it.node == nil {
+		return false
+	}
+	it.value = it.node.value
+	it.visited = true
+	return true
+}
+
+// above returns the node holding the smallest value larger than value, or nil
+func (tree *Tree) above(value interface{}) *_Node {
+	var above *_Node
+	node := tree.root
+	for node != nil {
+		if tree.larger(node.value, value) {
+			above = node
+			node = node.left
+		} else {
+			node = node.right()
+		}
+	}
+	return above
+}
@@ -0,0 +1,85 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_Iterator(t *testing.T) {
+	tree := RandomTree(200, 1000)
+	var expected, actual []interface{}
+	tree.Traverse(InOrder, func(value interface{}) {
+		expected = append(expected, value)
+	})
+	for it := tree.Iterator(); it.Next(); {
+		actual = append(actual, it.Value())
+	}
+	if fmt.Sprint(expected) != fmt.Sprint(actual) {
+		t.Errorf("Iterator: {Expected: %v | Actual: %v}", expected, actual)
+	}
+	if EmptyTree().Iterator().Next() {
+		t.Errorf("Next on empty tree: {Expected: false | Actual: true}")
+	}
+}
+
+func TestTree_IteratorModified(t *testing.T) {
+	tree := CompleteTree(100)
+	version := tree.Version()
+	previous := 0
+	count := 0
+	for it := tree.Iterator(); it.Next(); {
+		value := it.Value().(int)
+		if value <= previous {
+			t.Fatalf("Order: {Expected: > %d | Actual: %d}", previous, value)
+		}
+		previous = value
+		count++
+		// Delete the next value, insert one behind and one ahead of the iterator
+		tree.Delete(value + 1)
+		tree.Insert(-value)
+		if value < 50 {
+			tree.Insert(value + 1000)
+		}
+	}
+	// Odd values 1..99, plus 1001..1049 inserted ahead for the odd values below 50
+	if expected := 50 + 25; expected != count {
+		t.Errorf("Visited: {Expected: %d | Actual: %d}", expected, count)
+	}
+	if tree.Version() == version {
+		t.Errorf("Version: {Expected: != %d | Actual: %d}", version, tree.Version())
+	}
+}
+
+func TestTree_IteratorExhaustedEmpty(t *testing.T) {
+	for name, option := range map[string]Option{
+		"Stack":          WithBalancing(Unbalanced),
+		"ParentPointers": WithParentPointers(),
+		"Threads":        WithThreads(),
+	} {
+		tree := New(IntSmaller, IntLarger, option)
+		it := tree.Iterator()
+		if it.Next() {
+			t.Errorf("%s: Next on empty tree: {Expected: false | Actual: true}", name)
+		}
+		tree.Insert(2)
+		tree.Insert(1)
+		var values []interface{}
+		for it.Next() {
+			values = append(values, it.Value())
+		}
+		if expected := "[1 2]"; expected != fmt.Sprint(values) {
+			t.Errorf("%s: Values: {Expected: %s | Actual: %v}", name, expected, values)
+		}
+	}
+}
+
+// Iterate over a tree without holding its lock
+func ExampleTree_Iterator() {
+	tree := CompleteTree(5)
+	for it := tree.Iterator(); it.Next(); {
+		fmt.Printf("%d,", it.Value())
+	}
+	fmt.Printf("\n")
+	// Output:
+	// 1,2,3,4,5,
+}
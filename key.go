@@ -0,0 +1,56 @@
+package bstree
+
+// NewByKey creates an initialized tree ordering values by a key extracted from them
+// smaller and larger compare keys, not values. This makes it possible to
+// store structs and find them again using only their key with GetByKey.
+// Time-complexity: O(1)
+func NewByKey(key func(value interface{}) interface{}, smaller Smaller, larger Larger, options ...Option) *Tree {
+	tree := New(func(value interface{}, other interface{}) bool {
+		return smaller(key(value), key(other))
+	}, func(value interface{}, other interface{}) bool {
+		return larger(key(value), key(other))
+	}, options...)
+	tree.key = key
+	tree.keySmaller = smaller
+	tree.keyLarger = larger
+	return tree
+}
+
+// GetByKey returns the stored value with the given key
+// For trees not created by NewByKey, the key is the value itself.
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (tree *Tree) GetByKey(key interface{}) (interface{}, bool) {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	node := tree.findKey(key)
+	if node == nil {
+		return nil, false
+	}
+	return node.value, true
+}
+
+// findKey returns the topmost node whose value has the given key
+func (tree *Tree) findKey(key interface{}) *_Node {
+	smaller, larger := tree.smaller, tree.larger
+	if tree.key != nil {
+		smaller = func(key interface{}, value interface{}) bool {
+			return tree.keySmaller(key, tree.key(value))
+		}
+		larger = func(key interface{}, value interface{}) bool {
+			return tree.keyLarger(key, tree.key(value))
+		}
+	}
+	node := tree.root
+	for node != nil {
+		switch {
+		case smaller(key, node.value):
+			node = node.left
+		case larger(key, node.value):
+			node = node.right()
+		default:
+			return node
+		}
+	}
+	return nil
+}
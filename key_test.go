@@ -0,0 +1,49 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+type user struct {
+	id   int
+	name string
+}
+
+func userID(value interface{}) interface{} {
+	return value.(user).id
+}
+
+func TestTree_GetByKey(t *testing.T) {
+	tree := NewByKey(userID, IntSmaller, IntLarger)
+	for i := 0; i < 100; i++ {
+		tree.Insert(user{(i * 37) % 100, fmt.Sprint("user", (i*37)%100)})
+	}
+	if tree.Insert(user{5, "duplicate"}) {
+		t.Errorf("Insert(duplicate key): {Expected: false | Actual: true}")
+	}
+	for i := 0; i < 100; i++ {
+		value, ok := tree.GetByKey(i)
+		if !ok || value.(user).name != fmt.Sprint("user", i) {
+			t.Errorf("GetByKey(%d): {Expected: user%d true | Actual: %v %t}", i, i, value, ok)
+		}
+	}
+	if value, ok := tree.GetByKey(100); ok {
+		t.Errorf("GetByKey(100): {Expected: <nil> false | Actual: %v %t}", value, ok)
+	}
+	if value, ok := CompleteTree(5).GetByKey(3); !ok || value != 3 {
+		t.Errorf("GetByKey(3) without key: {Expected: 3 true | Actual: %v %t}", value, ok)
+	}
+}
+
+// Find a struct using only its key
+func ExampleNewByKey() {
+	tree := NewByKey(userID, IntSmaller, IntLarger)
+	tree.Insert(user{42, "alice"})
+	tree.Insert(user{7, "bob"})
+	fmt.Println(tree.GetByKey(42))
+	fmt.Println(tree.Delete(user{id: 7}), tree.Size())
+	// Output:
+	// {42 alice} true
+	// true 1
+}
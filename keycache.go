@@ -0,0 +1,50 @@
+package bstree
+
+// WithKeyCache speeds up trees with expensive comparators using cheap pre-keys
+// extract maps a value to a uint64 that has to agree with the comparators:
+// if extract(a) < extract(b) then a has to be smaller than b. Values with
+// equal pre-keys may be in any order, e.g. the first eight bytes of a string
+// in big-endian order make a valid pre-key. The pre-key of every stored value
+// is computed once and cached in its node, and lookups, inserts and deletes
+// only call the comparators when the pre-keys are equal, which includes
+// finding an equal value and, on Splay trees, moving the value to the root.
+// The cached extremes and WithAutoRebalance need no comparisons. extract is
+// called once per operation for the searched value.
+func WithKeyCache(extract func(value interface{}) uint64) Option {
+	return func(tree *Tree) {
+		tree.keyCache = extract
+	}
+}
+
+// setValue stores value in node and caches its pre-key
+func (tree *Tree) setValue(node *_Node, value interface{}) {
+	node.value = value
+	if tree.keyCache != nil {
+		fat(node).pre = tree.keyCache(value)
+	}
+}
+
+// preKey returns the pre-key of value, or 0 if the tree has no key cache
+// Operations compute it once and pass it to every call of order.
+func (tree *Tree) preKey(value interface{}) uint64 {
+	if tree.keyCache == nil {
+		return 0
+	}
+	return tree.keyCache(value)
+}
+
+// order compares value, whose pre-key is pre, with the value of node
+// It returns a negative number, zero or a positive number when value is
+// smaller than, equal to or larger than the value of node respectively.
+// Cached pre-keys are compared first, if the tree has a key cache.
+func (tree *Tree) order(value interface{}, pre uint64, node *_Node) int {
+	if tree.keyCache != nil {
+		switch {
+		case pre < fat(node).pre:
+			return -1
+		case pre > fat(node).pre:
+			return 1
+		}
+	}
+	return tree.compare(value, node.value)
+}
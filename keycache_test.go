@@ -0,0 +1,140 @@
+package bstree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// stringPreKey packs the first eight bytes of a string, which orders like the string
+func stringPreKey(value interface{}) uint64 {
+	var pre uint64
+	s := value.(string)
+	for i := 0; i < 8; i++ {
+		pre <<= 8
+		if i < len(s) {
+			pre |= uint64(s[i])
+		}
+	}
+	return pre
+}
+
+func TestTree_KeyCache(t *testing.T) {
+	compares := 0
+	smaller := func(value interface{}, other interface{}) bool {
+		compares++
+		return value.(string) < other.(string)
+	}
+	larger := func(value interface{}, other interface{}) bool {
+		compares++
+		return value.(string) > other.(string)
+	}
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(smaller, larger, backend, WithKeyCache(stringPreKey))
+		plain := Ordered[string](backend)
+		rng := rand.New(rand.NewSource(1))
+		for i := 0; i < 2000; i++ {
+			// Values share long prefixes, so some pre-keys tie
+			value := fmt.Sprintf("key-%06d", rng.Intn(1000))
+			if rng.Intn(3) == 0 {
+				if tree.Delete(value) != plain.Delete(value) {
+					t.Fatalf("Delete(%s) differs", value)
+				}
+			} else if tree.Insert(value) != plain.Insert(value) {
+				t.Fatalf("Insert(%s) differs", value)
+			}
+		}
+		mustCheck(t, tree)
+		if added, removed := plain.Diff(tree); len(added) != 0 || len(removed) != 0 {
+			t.Errorf("Diff: {Expected: [] [] | Actual: %v %v}", added, removed)
+		}
+	})
+	tree := New(smaller, larger, WithKeyCache(stringPreKey))
+	for i := 0; i < 100; i++ {
+		tree.Insert(fmt.Sprintf("%03d", rand.Intn(1000)))
+	}
+	compares = 0
+	tree.Exists("999")
+	if compares > 2 {
+		t.Errorf("Compares: {Expected: <= 2 | Actual: %d}", compares)
+	}
+}
+
+// Order strings by their first bytes before comparing them in full
+func ExampleWithKeyCache() {
+	tree := Ordered[string](WithKeyCache(stringPreKey))
+	tree.Insert("banana")
+	tree.Insert("apple")
+	tree.Insert("applesauce")
+	fmt.Println(tree)
+	// Output:
+	// {size: 3 | depth: 3 | min: apple | max: banana | values: [apple applesauce banana]}
+}
+
+func TestTree_KeyCacheExtractOnce(t *testing.T) {
+	if debug {
+		t.Skip("debug builds check every pre-key after each modification")
+	}
+	extracts := 0
+	extract := func(value interface{}) uint64 {
+		extracts++
+		return stringPreKey(value)
+	}
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(OrderedSmaller[string], OrderedLarger[string], backend, WithKeyCache(extract))
+		for i := 0; i < 1000; i++ {
+			tree.Insert(fmt.Sprintf("%08d", i))
+		}
+		extracts = 0
+		tree.Exists("00000500")
+		if expected := 1; expected != extracts {
+			t.Errorf("Exists extracts: {Expected: %d | Actual: %d}", expected, extracts)
+		}
+		extracts = 0
+		tree.Insert("00000500x")
+		// One for the search and one for the new node
+		if expected := 2; expected != extracts {
+			t.Errorf("Insert extracts: {Expected: %d | Actual: %d}", expected, extracts)
+		}
+	})
+}
+
+func TestTree_KeyCacheDistinctPreKeys(t *testing.T) {
+	if debug {
+		t.Skip("debug builds compare while checking each modification")
+	}
+	compares := 0
+	smaller := func(value interface{}, other interface{}) bool {
+		compares++
+		return value.(string) < other.(string)
+	}
+	larger := func(value interface{}, other interface{}) bool {
+		compares++
+		return value.(string) > other.(string)
+	}
+	// Splay trees are left out, as they find each value again after changing the tree
+	for _, options := range [][]Option{
+		{WithBalancing(Unbalanced), WithAutoRebalance(2)},
+		{WithBalancing(LLRB)},
+	} {
+		tree := New(smaller, larger, append(options, WithKeyCache(stringPreKey))...)
+		// The first eight bytes differ, so only equal values tie
+		for _, i := range rand.Perm(1000) {
+			tree.Insert(fmt.Sprintf("%08d", i))
+		}
+		tree.Exists("00001000")
+		tree.Delete("00001000")
+		tree.Insert("00001000")
+		if compares != 0 {
+			t.Errorf("Comparator calls without ties: {Expected: 0 | Actual: %d}", compares)
+		}
+		compares = 0
+		tree.Delete("00000500")
+		// Finding the value is a tie, which smaller and larger settle
+		if expected := 2; expected != compares {
+			t.Errorf("Comparator calls of Delete: {Expected: %d | Actual: %d}", expected, compares)
+		}
+		mustCheck(t, tree)
+		compares = 0
+	}
+}
@@ -0,0 +1,30 @@
+package bstree
+
+// Leaves calls visitor on the value of each node without children, in sorted order
+// Time-complexity: O(size)
+func (tree *Tree) Leaves(visitor Visitor) {
+	defer tree.recoverPanic()
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	tree.doLeaves(tree.root, 0, func(node *_Node, depth int) {
+		visitor(node.value)
+	})
+}
+
+// InternalNodes calls visitor on the value of each node with at least one child, in sorted order
+// Time-complexity: O(size)
+func (tree *Tree) InternalNodes(visitor Visitor) {
+	defer tree.recoverPanic()
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	tree.doInternalNodes(tree.root, visitor)
+}
+
+func (tree *Tree) doInternalNodes(node *_Node, visitor Visitor) {
+	if node == nil || (node.left == nil && node.right() == nil) {
+		return
+	}
+	tree.doInternalNodes(node.left, visitor)
+	visitor(node.value)
+	tree.doInternalNodes(node.right(), visitor)
+}
@@ -0,0 +1,42 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_LeavesAndInternalNodes(t *testing.T) {
+	tree := RandomTree(500, 1000)
+	var expectedLeaves, expectedInternal []interface{}
+	tree.TraverseNodes(InOrder, func(value interface{}, depth int, isLeaf bool) {
+		if isLeaf {
+			expectedLeaves = append(expectedLeaves, value)
+		} else {
+			expectedInternal = append(expectedInternal, value)
+		}
+	})
+	var leaves, internal []interface{}
+	tree.Leaves(func(value interface{}) {
+		leaves = append(leaves, value)
+	})
+	tree.InternalNodes(func(value interface{}) {
+		internal = append(internal, value)
+	})
+	if fmt.Sprint(expectedLeaves) != fmt.Sprint(leaves) {
+		t.Errorf("Leaves: {Expected: %v | Actual: %v}", expectedLeaves, leaves)
+	}
+	if fmt.Sprint(expectedInternal) != fmt.Sprint(internal) {
+		t.Errorf("InternalNodes: {Expected: %v | Actual: %v}", expectedInternal, internal)
+	}
+}
+
+// Visit only the leaves of a tree
+func ExampleTree_Leaves() {
+	tree := CompleteTree(7)
+	tree.Leaves(func(value interface{}) {
+		fmt.Print(value, ",")
+	})
+	fmt.Println()
+	// Output:
+	// 1,3,5,7,
+}
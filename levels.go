@@ -0,0 +1,56 @@
+package bstree
+
+// TraverseLevels calls visitor once per level of the tree, starting with the root at level 0
+// values holds the values of the level from left to right. Breadth-first
+// consumers like renderers or layered exports get the level boundaries
+// without reconstructing them from depths. visitor may keep values.
+// Time-complexity: O(size)
+func (tree *Tree) TraverseLevels(visitor func(level int, values []interface{})) {
+	defer tree.recoverPanic()
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	tree.doLevels(func(level int, nodes []*_Node) {
+		values := make([]interface{}, len(nodes))
+		for i, node := range nodes {
+			values[i] = node.value
+		}
+		visitor(level, values)
+	})
+}
+
+// doLevels calls visitor with the nodes of each level from left to right
+// The slice of nodes is reused for a later level once visitor returns.
+func (tree *Tree) doLevels(visitor func(level int, nodes []*_Node)) {
+	if tree.root == nil {
+		return
+	}
+	level, next := []*_Node{tree.root}, []*_Node(nil)
+	for depth := 0; len(level) > 0; depth++ {
+		visitor(depth, level)
+		next = next[:0]
+		for _, node := range level {
+			if node.left != nil {
+				next = append(next, node.left)
+			}
+			if node.right() != nil {
+				next = append(next, node.right())
+			}
+		}
+		level, next = next, level
+	}
+}
+
+// doZigZag visits the nodes level by level, reversing the direction on every odd level
+func (tree *Tree) doZigZag(visitor func(node *_Node, depth int)) {
+	tree.doLevels(func(level int, nodes []*_Node) {
+		if level%2 == 0 {
+			for _, node := range nodes {
+				visitor(node, level)
+			}
+			return
+		}
+		for i := len(nodes) - 1; i >= 0; i-- {
+			visitor(nodes[i], level)
+		}
+	})
+}
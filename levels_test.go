@@ -0,0 +1,76 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_TraverseLevels(t *testing.T) {
+	tree := CompleteTree(100)
+	var expected []string
+	tree.TraverseNodes(LevelOrder, func(value interface{}, depth int, isLeaf bool) {
+		expected = append(expected, fmt.Sprintf("%d:%v", depth, value))
+	})
+	var actual []string
+	previous := -1
+	tree.TraverseLevels(func(level int, values []interface{}) {
+		if level != previous+1 {
+			t.Errorf("Level: {Expected: %d | Actual: %d}", previous+1, level)
+		}
+		previous = level
+		for _, value := range values {
+			actual = append(actual, fmt.Sprintf("%d:%v", level, value))
+		}
+	})
+	if fmt.Sprint(expected) != fmt.Sprint(actual) {
+		t.Errorf("TraverseLevels: {Expected: %v | Actual: %v}", expected, actual)
+	}
+	EmptyTree().TraverseLevels(func(level int, values []interface{}) {
+		t.Errorf("TraverseLevels of empty tree: {Expected: no call | Actual: %d %v}", level, values)
+	})
+}
+
+func TestTree_ZigZagOrder(t *testing.T) {
+	tree := CompleteTree(15)
+	expected := "[8 12 4 2 6 10 14 15 13 11 9 7 5 3 1]"
+	var actual []interface{}
+	tree.Traverse(ZigZagOrder, func(value interface{}) {
+		actual = append(actual, value)
+	})
+	if expected != fmt.Sprint(actual) {
+		t.Errorf("Traverse(ZigZagOrder): {Expected: %s | Actual: %v}", expected, actual)
+	}
+	var depths []int
+	tree.TraverseNodes(ZigZagOrder, func(value interface{}, depth int, isLeaf bool) {
+		if isLeaf != (depth == 3) {
+			t.Errorf("isLeaf of %v: {Expected: %t | Actual: %t}", value, depth == 3, isLeaf)
+		}
+		depths = append(depths, depth)
+	})
+	if expected := "[0 1 1 2 2 2 2 3 3 3 3 3 3 3 3]"; expected != fmt.Sprint(depths) {
+		t.Errorf("TraverseNodes(ZigZagOrder) depths: {Expected: %s | Actual: %v}", expected, depths)
+	}
+}
+
+// Print a tree level by level
+func ExampleTree_TraverseLevels() {
+	tree := CompleteTree(7)
+	tree.TraverseLevels(func(level int, values []interface{}) {
+		fmt.Println(level, values)
+	})
+	// Output:
+	// 0 [4]
+	// 1 [2 6]
+	// 2 [1 3 5 7]
+}
+
+// Walk a tree in a spiral
+func ExampleTree_Traverse_zigZag() {
+	tree := CompleteTree(7)
+	tree.Traverse(ZigZagOrder, func(value interface{}) {
+		fmt.Print(value, ",")
+	})
+	fmt.Println()
+	// Output:
+	// 4,6,2,1,3,5,7,
+}
@@ -0,0 +1,215 @@
+package bstree
+
+// Balancing selects the algorithm used to keep a tree balanced
+type Balancing int32
+
+const (
+	// Unbalanced trees are shaped by the order of insertion
+	Unbalanced Balancing = iota
+	// LLRB trees are left-leaning red-black trees with a depth of at most 2*log2(size)
+	LLRB
+	// Splay trees move accessed values to the root, see splay.go
+	Splay
+)
+
+// WithBalancing selects the balancing algorithm of the tree
+// The default is Unbalanced.
+func WithBalancing(balancing Balancing) Option {
+	return func(tree *Tree) {
+		tree.balancing = balancing
+	}
+}
+
+// The LLRB implementation follows Sedgewick's "Left-leaning Red-Black Trees"
+// for 2-3 trees: red links lean left and no node has two red links.
+
+func isRed(node *_Node) bool {
+	return node != nil && node.red
+}
+
+func (tree *Tree) rotateLeft(node *_Node) *_Node {
+	right := node.right()
+	tree.setRight(node, right.left, right)
+	right.left = node
+	right.red = node.red
+	node.red = true
+	tree.update(node)
+	tree.update(right)
+	return right
+}
+
+func (tree *Tree) rotateRight(node *_Node) *_Node {
+	left := node.left
+	node.left = left.right()
+	tree.setRight(left, node, nil)
+	left.red = node.red
+	node.red = true
+	tree.update(node)
+	tree.update(left)
+	return left
+}
+
+func flipColors(node *_Node) {
+	node.red = !node.red
+	node.left.red = !node.left.red
+	node.right().red = !node.right().red
+}
+
+// llrbFixUp restores the LLRB invariants of node on the way up
+func (tree *Tree) llrbFixUp(node *_Node) *_Node {
+	if isRed(node.right()) && !isRed(node.left) {
+		node = tree.rotateLeft(node)
+	}
+	if isRed(node.left) && isRed(node.left.left) {
+		node = tree.rotateRight(node)
+	}
+	if isRed(node.left) && isRed(node.right()) {
+		flipColors(node)
+	}
+	tree.update(node)
+	return node
+}
+
+// llrbInsert adds value to the subtree rooted at node and returns the new subtree root
+// pre, next and descent are as in doInsert.
+func (tree *Tree) llrbInsert(node *_Node, value interface{}, pre uint64, next *_Node, descent *_Descent) (*_Node, bool) {
+	if node == nil {
+		node = tree.newNode(value, next)
+		node.red = true
+		return node, true
+	}
+	var inserted bool
+	switch order := tree.order(value, pre, node); {
+	case order < 0:
+		descent.left = true
+		node.left, inserted = tree.llrbInsert(node.left, value, pre, node, descent)
+	case order > 0, tree.duplicates:
+		descent.right = true
+		var right *_Node
+		right, inserted = tree.llrbInsert(node.right(), value, pre, next, descent)
+		tree.setRight(node, right, next)
+	}
+	if !inserted {
+		return node, false
+	}
+	return tree.llrbFixUp(node), true
+}
+
+// llrbDeleteRoot removes value from the tree rooted at root and returns the new root
+// The deletion restructures the tree on the way down, so the node to delete
+// is located by its rank first. That leaves the tree untouched if value
+// doesn't exist and keeps rotations from confusing it with an equal value.
+func (tree *Tree) llrbDeleteRoot(root *_Node, value interface{}, pre uint64, descent *_Descent) (*_Node, bool) {
+	rank, found := tree.deleteRank(value, pre, descent)
+	if !found {
+		return root, false
+	}
+	if !isRed(root.left) && !isRed(root.right()) {
+		root.red = true
+	}
+	root = tree.llrbDelete(root, rank, nil)
+	if root != nil {
+		root.red = false
+	}
+	return root, true
+}
+
+// deleteRank returns the in-order position of the node that Delete removes for value
+// It follows the same path as doDelete, so equal values are chosen alike,
+// and records it in descent.
+func (tree *Tree) deleteRank(value interface{}, pre uint64, descent *_Descent) (int, bool) {
+	rank := 0
+	node := tree.root
+	for node != nil {
+		switch order := tree.deleteOrder(value, pre, node); {
+		case order < 0:
+			descent.left = true
+			node = node.left
+		case order > 0:
+			descent.right = true
+			rank += sizeOf(node.left) + 1
+			node = node.right()
+		default:
+			return rank + sizeOf(node.left), true
+		}
+	}
+	return 0, false
+}
+
+func (tree *Tree) moveRedLeft(node *_Node) *_Node {
+	flipColors(node)
+	if isRed(node.right().left) {
+		tree.setRight(node, tree.rotateRight(node.right()), nil)
+		node = tree.rotateLeft(node)
+		flipColors(node)
+	}
+	return node
+}
+
+func (tree *Tree) moveRedRight(node *_Node) *_Node {
+	flipColors(node)
+	if isRed(node.left.left) {
+		node = tree.rotateRight(node)
+		flipColors(node)
+	}
+	return node
+}
+
+// llrbDelete removes the node at rank, which must exist, from the subtree rooted at node
+// Rotations keep the in-order sequence of a subtree, so the rank stays valid
+// while the subtree is restructured and no comparisons are needed. next is
+// the in-order successor of the subtree, see doInsert.
+func (tree *Tree) llrbDelete(node *_Node, rank int, next *_Node) *_Node {
+	if rank < sizeOf(node.left) {
+		if !isRed(node.left) && !isRed(node.left.left) {
+			node = tree.moveRedLeft(node)
+		}
+		node.left = tree.llrbDelete(node.left, rank, node)
+		return tree.llrbFixUp(node)
+	}
+	if isRed(node.left) {
+		node = tree.rotateRight(node)
+	}
+	if rank == sizeOf(node.left) && node.right() == nil {
+		tree.freeNode(node)
+		return nil
+	}
+	if !isRed(node.right()) && !isRed(node.right().left) {
+		node = tree.moveRedRight(node)
+	}
+	if rank == sizeOf(node.left) {
+		// Replace the value with its in-order successor and remove that instead
+		right, successor := tree.llrbDeleteMinimum(node.right())
+		tree.setRight(node, right, next)
+		tree.setValue(node, successor)
+	} else {
+		tree.setRight(node, tree.llrbDelete(node.right(), rank-sizeOf(node.left)-1, next), next)
+	}
+	return tree.llrbFixUp(node)
+}
+
+// llrbDeleteMinimum removes the smallest node of a non-empty subtree
+// It returns the new subtree root and the value of the removed node.
+func (tree *Tree) llrbDeleteMinimum(node *_Node) (*_Node, interface{}) {
+	if node.left == nil {
+		right, value := node.right(), node.value
+		tree.freeNode(node)
+		return right, value
+	}
+	if !isRed(node.left) && !isRed(node.left.left) {
+		node = tree.moveRedLeft(node)
+	}
+	var value interface{}
+	node.left, value = tree.llrbDeleteMinimum(node.left)
+	return tree.llrbFixUp(node), value
+}
+
+// llrbBuild creates an LLRB tree from sorted values
+func (tree *Tree) llrbBuild(values []interface{}) *_Node {
+	var root *_Node
+	for _, value := range values {
+		root, _ = tree.llrbInsert(root, value, tree.preKey(value), nil, &_Descent{})
+		root.red = false
+	}
+	return root
+}
@@ -0,0 +1,126 @@
+package bstree
+
+import (
+	"math/rand"
+	"sync/atomic"
+	"time"
+)
+
+// _LockStats samples how long a tree waits for its lock
+type _LockStats struct {
+	sampleRate int
+	reads      _LockWaits
+	writes     _LockWaits
+}
+
+// _LockWaits accumulates the sampled waits for one kind of lock
+type _LockWaits struct {
+	sampled atomic.Int64
+	waited  atomic.Int64
+	total   atomic.Int64 // nanoseconds
+	max     atomic.Int64 // nanoseconds
+}
+
+// LockStats describes how long operations waited for the lock of a tree
+// Only one in SampleRate acquisitions is measured, so multiply the counts
+// and durations by SampleRate to estimate the totals.
+type LockStats struct {
+	SampleRate int       `json:"sample_rate"`
+	Reads      LockWaits `json:"reads"`
+	Writes     LockWaits `json:"writes"`
+}
+
+// LockWaits describes the sampled acquisitions of the read or write lock
+type LockWaits struct {
+	Sampled  int64         `json:"sampled"`   // measured acquisitions
+	Waited   int64         `json:"waited"`    // measured acquisitions that found the lock busy
+	WaitTime time.Duration `json:"wait_time"` // time the measured acquisitions spent waiting
+	MaxWait  time.Duration `json:"max_wait"`  // longest wait of a measured acquisition
+}
+
+// WithLockStats makes the tree measure one in sampleRate acquisitions of its lock for LockStats
+// A measured acquisition first tries to take the lock without waiting,
+// and only reads the clock if that fails. A sampleRate of 1 measures every
+// acquisition. Operations giving up WithOpTimeout aren't measured.
+func WithLockStats(sampleRate int) Option {
+	return func(tree *Tree) {
+		tree.lockStats = &_LockStats{sampleRate: max(sampleRate, 1)}
+	}
+}
+
+// LockStats returns the sampled waits for the read and write lock
+// It is the zero LockStats unless the tree was created WithLockStats.
+// Time-complexity: O(1)
+func (tree *Tree) LockStats() LockStats {
+	stats := tree.lockStats
+	if stats == nil {
+		return LockStats{}
+	}
+	return LockStats{
+		SampleRate: stats.sampleRate,
+		Reads:      stats.reads.snapshot(),
+		Writes:     stats.writes.snapshot(),
+	}
+}
+
+// readLock takes the read lock, measuring the wait if the acquisition is sampled
+func (tree *Tree) readLock() {
+	stats := tree.lockStats
+	if stats == nil || !stats.sample() {
+		tree.mutex.RLock()
+		return
+	}
+	if tree.mutex.TryRLock() {
+		stats.reads.record(0)
+		return
+	}
+	start := time.Now()
+	tree.mutex.RLock()
+	stats.reads.record(time.Since(start))
+}
+
+// writeLock takes the write lock, measuring the wait if the acquisition is sampled
+func (tree *Tree) writeLock() {
+	stats := tree.lockStats
+	if stats == nil || !stats.sample() {
+		tree.mutex.Lock()
+		return
+	}
+	if tree.mutex.TryLock() {
+		stats.writes.record(0)
+		return
+	}
+	start := time.Now()
+	tree.mutex.Lock()
+	stats.writes.record(time.Since(start))
+}
+
+// sample decides if an acquisition is measured
+func (stats *_LockStats) sample() bool {
+	return stats.sampleRate == 1 || rand.Intn(stats.sampleRate) == 0
+}
+
+// record adds a measured acquisition that waited for d
+func (waits *_LockWaits) record(d time.Duration) {
+	waits.sampled.Add(1)
+	if d == 0 {
+		return
+	}
+	waits.waited.Add(1)
+	waits.total.Add(int64(d))
+	for longest := waits.max.Load(); int64(d) > longest; longest = waits.max.Load() {
+		if waits.max.CompareAndSwap(longest, int64(d)) {
+			break
+		}
+	}
+}
+
+// snapshot returns the current values of the counters
+func (waits *_LockWaits) snapshot() LockWaits {
+	return LockWaits{
+		Sampled:  waits.sampled.Load(),
+		Waited:   waits.waited.Load(),
+		WaitTime: time.Duration(waits.total.Load()),
+		MaxWait:  time.Duration(waits.max.Load()),
+	}
+}
@@ -0,0 +1,59 @@
+package bstree
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTree_LockStats(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithLockStats(1))
+	var wg sync.WaitGroup
+	tree.View(func(view ReadView) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tree.Insert(1)
+		}()
+		time.Sleep(5 * time.Millisecond)
+	})
+	wg.Wait()
+	stats := tree.LockStats()
+	if stats.SampleRate != 1 || stats.Reads.Sampled != 1 || stats.Writes.Sampled != 1 {
+		t.Errorf("Sampled: {Expected: 1 1 1 | Actual: %d %d %d}", stats.SampleRate, stats.Reads.Sampled, stats.Writes.Sampled)
+	}
+	if stats.Writes.Waited != 1 || stats.Writes.MaxWait != stats.Writes.WaitTime || stats.Writes.MaxWait < time.Millisecond {
+		t.Errorf("Writes: {Expected: one wait of a few ms | Actual: %+v}", stats.Writes)
+	}
+	if stats.Reads.Waited != 0 || stats.Reads.WaitTime != 0 {
+		t.Errorf("Reads: {Expected: no waits | Actual: %+v}", stats.Reads)
+	}
+	if stats := EmptyTree().LockStats(); stats != (LockStats{}) {
+		t.Errorf("Without WithLockStats: {Expected: %+v | Actual: %+v}", LockStats{}, stats)
+	}
+}
+
+func TestWithLockStats_Sampled(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithLockStats(10))
+	for i := 0; i < 10000; i++ {
+		tree.Exists(i)
+	}
+	if sampled := tree.LockStats().Reads.Sampled; sampled < 800 || sampled > 1200 {
+		t.Errorf("Reads sampled: {Expected: about 1000 | Actual: %d}", sampled)
+	}
+}
+
+// Count the acquisitions of the lock
+func ExampleTree_LockStats() {
+	tree := New(IntSmaller, IntLarger, WithLockStats(1))
+	for _, value := range []int{5, 2, 8} {
+		tree.Insert(value)
+	}
+	tree.Exists(2)
+	tree.Exists(3)
+	stats := tree.LockStats()
+	fmt.Println(stats.Writes.Sampled, stats.Reads.Sampled, stats.Writes.Waited)
+	// Output:
+	// 3 2 0
+}
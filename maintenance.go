@@ -0,0 +1,118 @@
+package bstree
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+// ErrBadInterval is returned by StartMaintenance for intervals that aren't positive
+var ErrBadInterval = errors.New("bstree: maintenance interval must be positive")
+
+// _Maintenance is the state of a background maintenance goroutine
+type _Maintenance struct {
+	stop   chan struct{}
+	done   chan struct{}
+	paused atomic.Bool
+}
+
+// StartMaintenance starts a goroutine that rebalances the tree every interval if needed
+// A tree is rebalanced when it is deeper than the WithAutoRebalance threshold,
+// or twice the optimal depth without it. The goroutine only ever try-locks the
+// tree, so ticks that find it in use are skipped and maintenance happens
+// during quiet periods without delaying readers or writers. Trees using a
+// balancing algorithm other than Unbalanced are never rebuilt.
+// Calling StartMaintenance again replaces the running goroutine.
+// Call StopMaintenance to release the goroutine once the tree is no longer used.
+// An interval that isn't positive fails with ErrBadInterval and leaves any
+// running goroutine alone.
+func (tree *Tree) StartMaintenance(interval time.Duration) error {
+	if interval <= 0 {
+		return ErrBadInterval
+	}
+	maintenance := &_Maintenance{stop: make(chan struct{}), done: make(chan struct{})}
+	tree.mutex.Lock()
+	previous := tree.maintenance
+	tree.maintenance = maintenance
+	tree.mutex.Unlock()
+	previous.halt()
+	go tree.doMaintenance(maintenance, interval)
+	return nil
+}
+
+// PauseMaintenance suspends background maintenance until ResumeMaintenance is called
+// Time-complexity: O(1)
+func (tree *Tree) PauseMaintenance() {
+	tree.mutex.RLock()
+	defer tree.mutex.RUnlock()
+	if tree.maintenance != nil {
+		tree.maintenance.paused.Store(true)
+	}
+}
+
+// ResumeMaintenance resumes background maintenance suspended by PauseMaintenance
+// Time-complexity: O(1)
+func (tree *Tree) ResumeMaintenance() {
+	tree.mutex.RLock()
+	defer tree.mutex.RUnlock()
+	if tree.maintenance != nil {
+		tree.maintenance.paused.Store(false)
+	}
+}
+
+// StopMaintenance stops background maintenance and waits for the goroutine to exit
+func (tree *Tree) StopMaintenance() {
+	tree.mutex.Lock()
+	maintenance := tree.maintenance
+	tree.maintenance = nil
+	tree.mutex.Unlock()
+	maintenance.halt()
+}
+
+// halt stops the goroutine and waits for it to exit
+func (maintenance *_Maintenance) halt() {
+	if maintenance == nil {
+		return
+	}
+	close(maintenance.stop)
+	<-maintenance.done
+}
+
+func (tree *Tree) doMaintenance(maintenance *_Maintenance, interval time.Duration) {
+	defer close(maintenance.done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-maintenance.stop:
+			return
+		case <-ticker.C:
+			if !maintenance.paused.Load() {
+				tree.maintain()
+			}
+		}
+	}
+}
+
+// maintain rebalances the tree if it is idle and too deep
+// Returns true if the tree was rebuilt.
+// Time-complexity: O(size)
+func (tree *Tree) maintain() bool {
+	if !tree.mutex.TryLock() {
+		return false
+	}
+	defer tree.mutex.Unlock()
+	if tree.balancing != Unbalanced || tree.size == 0 {
+		return false
+	}
+	depth := tree.doDepth(tree.root)
+	if tree.autoRebalance > 0 {
+		if !tree.unbalanced(depth) {
+			return false
+		}
+	} else if depth <= 2*minimalDepth(tree.size) {
+		return false
+	}
+	tree.rebalance()
+	return true
+}
@@ -0,0 +1,70 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestTree_Maintain(t *testing.T) {
+	tree := New(IntSmaller, IntLarger)
+	for i := 0; i < 100; i++ {
+		tree.Insert(i)
+	}
+	if !tree.maintain() {
+		t.Errorf("maintain: {Expected: true | Actual: false}")
+	}
+	if expected := 7; expected != tree.Depth() {
+		t.Errorf("Depth: {Expected: %d | Actual: %d}", expected, tree.Depth())
+	}
+	if tree.maintain() {
+		t.Errorf("maintain balanced: {Expected: false | Actual: true}")
+	}
+	mustCheck(t, tree)
+}
+
+func TestTree_PauseMaintenance(t *testing.T) {
+	tree := New(IntSmaller, IntLarger)
+	tree.StartMaintenance(time.Millisecond)
+	tree.PauseMaintenance()
+	for i := 0; i < 100; i++ {
+		tree.Insert(i)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if expected := 100; expected != tree.Depth() {
+		t.Errorf("Depth while paused: {Expected: %d | Actual: %d}", expected, tree.Depth())
+	}
+	tree.ResumeMaintenance()
+	deadline := time.Now().Add(5 * time.Second)
+	for tree.Depth() != 7 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if expected := 7; expected != tree.Depth() {
+		t.Errorf("Depth after resuming: {Expected: %d | Actual: %d}", expected, tree.Depth())
+	}
+	tree.StopMaintenance()
+	tree.StopMaintenance()
+}
+
+func TestTree_StartMaintenanceBadInterval(t *testing.T) {
+	tree := New(IntSmaller, IntLarger)
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if err := tree.StartMaintenance(interval); err != ErrBadInterval {
+			t.Errorf("StartMaintenance(%v): {Expected: %v | Actual: %v}", interval, ErrBadInterval, err)
+		}
+	}
+	tree.StopMaintenance()
+}
+
+// Keep a long-lived tree balanced in the background
+func ExampleTree_StartMaintenance() {
+	tree := New(IntSmaller, IntLarger)
+	tree.StartMaintenance(time.Minute)
+	defer tree.StopMaintenance()
+	for i := 0; i < 10; i++ {
+		tree.Insert(i)
+	}
+	fmt.Println(tree.Size())
+	// Output:
+	// 10
+}
@@ -0,0 +1,175 @@
+package bstree
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrBadSnapshot is returned when a snapshot file cannot be read
+var ErrBadSnapshot = errors.New("bstree: malformed snapshot file")
+
+// snapshotMagic identifies snapshot files and their format version
+var snapshotMagic = [8]byte{'B', 'S', 'T', 'S', 'N', 'A', 'P', '1'}
+
+// Layout of a snapshot file, all integers little-endian uint64:
+//
+//	magic | count | offsets[count+1] | encoded values in ascending order
+//
+// Value i occupies the bytes from offsets[i] up to offsets[i+1], which are
+// absolute positions in the file. A reader only needs the fixed-size
+// header to binary search the values, so the file can be queried in place,
+// e.g. through an mmap'd byte slice wrapped in a bytes.Reader.
+const snapshotHeader = 16
+
+// WriteSnapshot writes the values of the tree to w in the snapshot file layout
+// Values are encoded with the encoder of the tree, LineEncoder by default.
+// Each value is written as soon as it is encoded; the offsets follow last.
+// Time-complexity: O(size)
+func (tree *Tree) WriteSnapshot(w io.WriterAt) error {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	encoder := tree.encoder
+	if encoder == nil {
+		encoder = LineEncoder
+	}
+	offsets := make([]byte, 8*(tree.size+1))
+	position := int64(snapshotHeader + len(offsets))
+	binary.LittleEndian.PutUint64(offsets, uint64(position))
+	var buffer bytes.Buffer
+	var err error
+	i := 0
+	tree.doInOrder(tree.root, func(value interface{}) {
+		if err != nil {
+			return
+		}
+		buffer.Reset()
+		if err = encoder(&buffer, value); err != nil {
+			return
+		}
+		if _, err = w.WriteAt(buffer.Bytes(), position); err != nil {
+			return
+		}
+		position += int64(buffer.Len())
+		i++
+		binary.LittleEndian.PutUint64(offsets[8*i:], uint64(position))
+	})
+	if err != nil {
+		return err
+	}
+	header := make([]byte, snapshotHeader)
+	copy(header, snapshotMagic[:])
+	binary.LittleEndian.PutUint64(header[8:], uint64(tree.size))
+	if _, err := w.WriteAt(header, 0); err != nil {
+		return err
+	}
+	_, err = w.WriteAt(offsets, snapshotHeader)
+	return err
+}
+
+// MappedSnapshot queries a snapshot file in place without loading it into nodes
+// Each lookup reads and decodes only the values it compares against. It is
+// safe for use by concurrent goroutines if the underlying io.ReaderAt is.
+type MappedSnapshot struct {
+	reader  io.ReaderAt
+	count   int
+	decode  func(data []byte) (interface{}, error)
+	smaller Smaller
+	larger  Larger
+}
+
+// OpenSnapshot opens a snapshot file written by WriteSnapshot
+// decode converts the bytes written by the encoder back to a value;
+// smaller and larger must order values like the tree that wrote the file.
+// Time-complexity: O(1)
+func OpenSnapshot(r io.ReaderAt, decode func(data []byte) (interface{}, error), smaller Smaller, larger Larger) (*MappedSnapshot, error) {
+	header := make([]byte, snapshotHeader)
+	if _, err := r.ReadAt(header, 0); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBadSnapshot, err)
+	}
+	if !bytes.Equal(header[:8], snapshotMagic[:]) {
+		return nil, fmt.Errorf("%w: unknown format", ErrBadSnapshot)
+	}
+	count := binary.LittleEndian.Uint64(header[8:])
+	if count > uint64(^uint(0)>>4) {
+		return nil, fmt.Errorf("%w: %d values", ErrBadSnapshot, count)
+	}
+	return &MappedSnapshot{reader: r, count: int(count), decode: decode, smaller: smaller, larger: larger}, nil
+}
+
+// Size returns the number of values in the snapshot
+// Time-complexity: O(1)
+func (snapshot *MappedSnapshot) Size() int {
+	return snapshot.count
+}
+
+// Value returns the i-th smallest value of the snapshot, counting from 0
+// Time-complexity: O(1)
+func (snapshot *MappedSnapshot) Value(i int) (interface{}, error) {
+	if i < 0 || i >= snapshot.count {
+		return nil, fmt.Errorf("bstree: snapshot index %d out of range [0, %d)", i, snapshot.count)
+	}
+	bounds := make([]byte, 16)
+	if _, err := snapshot.reader.ReadAt(bounds, snapshotHeader+8*int64(i)); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBadSnapshot, err)
+	}
+	start, end := binary.LittleEndian.Uint64(bounds), binary.LittleEndian.Uint64(bounds[8:])
+	if end < start || end-start > uint64(^uint(0)>>1) {
+		return nil, fmt.Errorf("%w: bad offsets of value %d", ErrBadSnapshot, i)
+	}
+	data := make([]byte, end-start)
+	if _, err := snapshot.reader.ReadAt(data, int64(start)); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBadSnapshot, err)
+	}
+	return snapshot.decode(data)
+}
+
+// Ceiling returns the position of the smallest value larger than or equal to value
+// It returns Size() if there is no such value.
+// Time-complexity: O(log(size))
+func (snapshot *MappedSnapshot) Ceiling(value interface{}) (int, error) {
+	low, high := 0, snapshot.count
+	for low < high {
+		middle := int(uint(low+high) >> 1)
+		stored, err := snapshot.Value(middle)
+		if err != nil {
+			return 0, err
+		}
+		if snapshot.smaller(stored, value) {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+	return low, nil
+}
+
+// Exists checks if a value exists in the snapshot
+// Time-complexity: O(log(size))
+func (snapshot *MappedSnapshot) Exists(value interface{}) (bool, error) {
+	i, err := snapshot.Ceiling(value)
+	if err != nil || i == snapshot.count {
+		return false, err
+	}
+	stored, err := snapshot.Value(i)
+	if err != nil {
+		return false, err
+	}
+	return !snapshot.larger(stored, value), nil
+}
+
+// Traverse calls visitor on each value of the snapshot in sorted order
+// It stops at the first error.
+// Time-complexity: O(size)
+func (snapshot *MappedSnapshot) Traverse(visitor Visitor) error {
+	for i := 0; i < snapshot.count; i++ {
+		value, err := snapshot.Value(i)
+		if err != nil {
+			return err
+		}
+		visitor(value)
+	}
+	return nil
+}
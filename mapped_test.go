@@ -0,0 +1,84 @@
+package bstree
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func decodeIntLine(data []byte) (interface{}, error) {
+	return strconv.Atoi(strings.TrimSpace(string(data)))
+}
+
+func TestWriteSnapshot(t *testing.T) {
+	tree := RandomTree(500, 1000)
+	file, err := os.Create(filepath.Join(t.TempDir(), "tree.snap"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	if err := tree.WriteSnapshot(file); err != nil {
+		t.Fatalf("WriteSnapshot: {Expected: <nil> | Actual: %v}", err)
+	}
+	snapshot, err := OpenSnapshot(file, decodeIntLine, IntSmaller, IntLarger)
+	if err != nil {
+		t.Fatalf("OpenSnapshot: {Expected: <nil> | Actual: %v}", err)
+	}
+	if expected := tree.Size(); expected != snapshot.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, snapshot.Size())
+	}
+	for i := -1; i <= 1000; i++ {
+		found, err := snapshot.Exists(i)
+		if expected := tree.Exists(i); err != nil || expected != found {
+			t.Errorf("Exists(%d): {Expected: %t | Actual: %t, %v}", i, expected, found, err)
+		}
+	}
+	var values []interface{}
+	if err := snapshot.Traverse(func(value interface{}) { values = append(values, value) }); err != nil {
+		t.Errorf("Traverse: {Expected: <nil> | Actual: %v}", err)
+	}
+	if expected := fmt.Sprint(Values(tree)); expected != fmt.Sprint(values) {
+		t.Errorf("Traverse: {Expected: %s | Actual: %v}", expected, values)
+	}
+}
+
+func TestOpenSnapshot_Malformed(t *testing.T) {
+	for _, data := range []string{"", "BSTSNAP", "NOTASNAP\x00\x00\x00\x00\x00\x00\x00\x00"} {
+		if _, err := OpenSnapshot(strings.NewReader(data), decodeIntLine, IntSmaller, IntLarger); !errors.Is(err, ErrBadSnapshot) {
+			t.Errorf("OpenSnapshot(%q): {Expected: %v | Actual: %v}", data, ErrBadSnapshot, err)
+		}
+	}
+	truncated := "BSTSNAP1\x05\x00\x00\x00\x00\x00\x00\x00"
+	snapshot, err := OpenSnapshot(strings.NewReader(truncated), decodeIntLine, IntSmaller, IntLarger)
+	if err != nil {
+		t.Fatalf("OpenSnapshot: {Expected: <nil> | Actual: %v}", err)
+	}
+	if _, err := snapshot.Exists(3); !errors.Is(err, ErrBadSnapshot) {
+		t.Errorf("Exists(3): {Expected: %v | Actual: %v}", ErrBadSnapshot, err)
+	}
+}
+
+// Write a tree to a file and query it without loading it
+func ExampleOpenSnapshot() {
+	dir, _ := os.MkdirTemp("", "snapshot")
+	defer os.RemoveAll(dir)
+	file, _ := os.Create(filepath.Join(dir, "tree.snap"))
+	defer file.Close()
+	tree := New(IntSmaller, IntLarger)
+	for _, value := range []int{5, 2, 8} {
+		tree.Insert(value)
+	}
+	tree.WriteSnapshot(file)
+	snapshot, _ := OpenSnapshot(file, decodeIntLine, IntSmaller, IntLarger)
+	fmt.Println(snapshot.Exists(8))
+	fmt.Println(snapshot.Exists(3))
+	fmt.Println(snapshot.Value(0))
+	// Output:
+	// true <nil>
+	// false <nil>
+	// 2 <nil>
+}
@@ -0,0 +1,113 @@
+package bstree
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrOrderChanged is returned by UpdateWhere if a transform changes the position of a value
+var ErrOrderChanged = errors.New("bstree: transform changed the ordering of a value")
+
+// Map replaces every value of the tree with transform(value)
+// The transformed values are re-sorted and the tree is rebuilt balanced.
+// Values that become equal are merged unless the tree has duplicates.
+// The write-ahead log and hooks see the change as a delete of every old
+// value followed by an insert of every new one. The error is only set for
+// trees created WithOpTimeout that gave up waiting for the lock.
+// Time-complexity: O(size * log(size))
+func (tree *Tree) Map(transform func(value interface{}) interface{}) error {
+	if err := tree.lockOp("Map"); err != nil {
+		return err
+	}
+	defer tree.mutex.Unlock()
+	old := tree.values()
+	mapped := make([]interface{}, len(old))
+	for i, value := range old {
+		mapped[i] = tree.own(transform(value))
+	}
+	sort.SliceStable(mapped, func(a, b int) bool {
+		return tree.smaller(mapped[a], mapped[b])
+	})
+	if !tree.duplicates {
+		mapped = dedupSorted(tree.larger, mapped)
+	}
+	tree.freeSubtree(tree.root)
+	tree.load(mapped)
+	for _, value := range old {
+		tree.wal.log(_WALDelete, value)
+		tree.hooks.fireDelete(value)
+	}
+	for _, value := range mapped {
+		tree.wal.log(_WALInsert, value)
+		tree.hooks.fireInsert(value)
+	}
+	return nil
+}
+
+// UpdateWhere replaces every value matching pred with transform(value) in place
+// It is meant for updating payloads that don't take part in the ordering.
+// If any transformed value does not compare equal to the original, nothing
+// is updated and ErrOrderChanged is returned. Returns the number of updated values.
+// The write-ahead log and hooks see every update as a delete followed by an insert.
+// Time-complexity: O(size)
+func (tree *Tree) UpdateWhere(pred func(value interface{}) bool, transform func(value interface{}) interface{}) (int, error) {
+	if err := tree.lockOp("UpdateWhere"); err != nil {
+		return 0, err
+	}
+	defer tree.mutex.Unlock()
+	type update struct {
+		node  *_Node
+		value interface{}
+	}
+	var updates []update
+	tree.doInOrderNodes(tree.root, func(node *_Node) {
+		if pred(node.value) {
+			updates = append(updates, update{node, tree.own(transform(node.value))})
+		}
+	})
+	for _, u := range updates {
+		if tree.smaller(u.value, u.node.value) || tree.larger(u.value, u.node.value) {
+			return 0, ErrOrderChanged
+		}
+	}
+	if len(updates) == 0 {
+		return 0, nil
+	}
+	for _, u := range updates {
+		old := u.node.value
+		tree.setValue(u.node, u.value)
+		tree.wal.log(_WALDelete, old)
+		tree.wal.log(_WALInsert, u.value)
+		tree.hooks.fireDelete(old)
+		tree.hooks.fireInsert(u.value)
+	}
+	// Summaries may depend on the payload
+	if tree.augment != nil || tree.merkle != nil {
+		tree.updateAll(tree.root)
+	}
+	tree.version++
+	tree.snapshot.invalidate()
+	tree.refreshExtremes()
+	tree.verify()
+	return len(updates), nil
+}
+
+// doInOrderNodes calls visitor on every node of the subtree in order
+func (tree *Tree) doInOrderNodes(node *_Node, visitor func(node *_Node)) {
+	if node == nil {
+		return
+	}
+	tree.doInOrderNodes(node.left, visitor)
+	visitor(node)
+	tree.doInOrderNodes(node.right(), visitor)
+}
+
+// updateAll recomputes the bookkeeping of every node in the subtree bottom-up
+func (tree *Tree) updateAll(node *_Node) {
+	if node == nil {
+		return
+	}
+	tree.updateAll(node.left)
+	tree.updateAll(node.right())
+	tree.update(node)
+}
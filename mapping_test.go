@@ -0,0 +1,88 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_Map(t *testing.T) {
+	tree := CompleteTree(100)
+	events := 0
+	tree.OnInsert(func(value interface{}) { events++ })
+	tree.Map(func(value interface{}) interface{} {
+		return (value.(int) * 7) % 50
+	})
+	if expected := 50; expected != tree.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+	}
+	if expected := 50; expected != events {
+		t.Errorf("Insert events: {Expected: %d | Actual: %d}", expected, events)
+	}
+	if tree.Minimum() != 0 || tree.Maximum() != 49 {
+		t.Errorf("MinMax: {Expected: 0,49 | Actual: %v,%v}", tree.Minimum(), tree.Maximum())
+	}
+	mustCheck(t, tree)
+}
+
+func TestTree_MapAllocator(t *testing.T) {
+	allocator := new(_CountingAllocator)
+	tree := New(IntSmaller, IntLarger, WithAllocator(allocator))
+	for i := 0; i < 100; i++ {
+		tree.Insert(i)
+	}
+	tree.Map(func(value interface{}) interface{} {
+		return value.(int) / 2
+	})
+	if tree.Size() != allocator.inuse {
+		t.Errorf("Cells in use: {Expected: %d | Actual: %d}", tree.Size(), allocator.inuse)
+	}
+}
+
+func TestTree_UpdateWhere(t *testing.T) {
+	tree := NewByKey(userID, IntSmaller, IntLarger, WithAugment(func(value interface{}, left Aug, right Aug) Aug {
+		length := len(value.(user).name)
+		if left != nil {
+			length += left.(int)
+		}
+		if right != nil {
+			length += right.(int)
+		}
+		return length
+	}))
+	for i := 0; i < 10; i++ {
+		tree.Insert(user{i, "x"})
+	}
+	count, err := tree.UpdateWhere(func(value interface{}) bool {
+		return value.(user).id%2 == 0
+	}, func(value interface{}) interface{} {
+		return user{value.(user).id, "even"}
+	})
+	if count != 5 || err != nil {
+		t.Errorf("UpdateWhere: {Expected: 5 <nil> | Actual: %d %v}", count, err)
+	}
+	if expected := 5*4 + 5; expected != tree.Aggregate() {
+		t.Errorf("Aggregate: {Expected: %d | Actual: %v}", expected, tree.Aggregate())
+	}
+	count, err = tree.UpdateWhere(func(value interface{}) bool {
+		return true
+	}, func(value interface{}) interface{} {
+		return user{value.(user).id + 1, "moved"}
+	})
+	if count != 0 || err != ErrOrderChanged {
+		t.Errorf("UpdateWhere changing keys: {Expected: 0 %v | Actual: %d %v}", ErrOrderChanged, count, err)
+	}
+	if value, _ := tree.GetByKey(3); value.(user).name != "x" {
+		t.Errorf("GetByKey(3): {Expected: x | Actual: %v}", value)
+	}
+}
+
+// Rebuild a tree with transformed values
+func ExampleTree_Map() {
+	tree := CompleteTree(5)
+	tree.Map(func(value interface{}) interface{} {
+		return -value.(int)
+	})
+	fmt.Println(tree)
+	// Output:
+	// {size: 5 | depth: 3 | min: -5 | max: -1 | values: [-5 -4 -3 -2 -1]}
+}
@@ -0,0 +1,45 @@
+package bstree
+
+import "unsafe"
+
+// Sizer estimates the number of bytes a value refers to beyond its interface header
+// For example, the Sizer of a tree of strings would return len(value).
+type Sizer func(value interface{}) int64
+
+// WithSizer lets MemoryUsage and Profile account for the memory held by the values
+func WithSizer(sizer Sizer) Option {
+	return func(tree *Tree) {
+		tree.sizer = sizer
+	}
+}
+
+// MemoryUsage estimates the number of bytes used by the tree
+// It counts the tree and its nodes and, for trees created WithSizer,
+// the sizes of the values. Memory of allocators, snapshots and the
+// write-ahead log is not included.
+// Time-complexity: O(1) without a Sizer, O(size) with one
+func (tree *Tree) MemoryUsage() int64 {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	return int64(unsafe.Sizeof(*tree)) + tree.nodeBytes() + tree.valueBytes()
+}
+
+// nodeBytes estimates the memory used by the nodes, excluding the values
+func (tree *Tree) nodeBytes() int64 {
+	if tree.fatNodes() {
+		return int64(tree.size) * int64(unsafe.Sizeof(_FatNode{}))
+	}
+	return int64(tree.size) * int64(unsafe.Sizeof(_Node{}))
+}
+
+// valueBytes sums the sizes of all values, or returns 0 without a Sizer
+func (tree *Tree) valueBytes() int64 {
+	if tree.sizer == nil {
+		return 0
+	}
+	var bytes int64
+	tree.doInOrder(tree.root, func(value interface{}) {
+		bytes += tree.sizer(value)
+	})
+	return bytes
+}
@@ -0,0 +1,63 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+	"unsafe"
+)
+
+func TestTree_MemoryUsage(t *testing.T) {
+	empty := EmptyTree().MemoryUsage()
+	tree := CompleteTree(100)
+	if tree.MemoryUsage() <= empty {
+		t.Errorf("MemoryUsage: {Expected: > %d | Actual: %d}", empty, tree.MemoryUsage())
+	}
+	sized := New(IntSmaller, IntLarger, WithSizer(func(value interface{}) int64 {
+		return 100
+	}))
+	for i := 0; i < 100; i++ {
+		sized.Insert(i)
+	}
+	if expected := tree.MemoryUsage() + 100*100; expected != sized.MemoryUsage() {
+		t.Errorf("MemoryUsage with Sizer: {Expected: %d | Actual: %d}", expected, sized.MemoryUsage())
+	}
+	if expected := int64(100 * 100); expected != sized.Profile().ValueBytes {
+		t.Errorf("ValueBytes: {Expected: %d | Actual: %d}", expected, sized.Profile().ValueBytes)
+	}
+}
+
+func TestTree_NodeSize(t *testing.T) {
+	plain, fat := int64(unsafe.Sizeof(_Node{})), int64(unsafe.Sizeof(_FatNode{}))
+	if limit := int64(48); plain > limit {
+		t.Errorf("Node size: {Expected: <= %d | Actual: %d}", limit, plain)
+	}
+	for _, test := range []struct {
+		name     string
+		option   Option
+		expected int64
+	}{
+		{"Plain", WithBalancing(Unbalanced), plain},
+		{"WithThreads", WithThreads(), plain},
+		{"WithAllocator", WithAllocator(NewSlabAllocator(64)), plain},
+		{"WithParentPointers", WithParentPointers(), fat},
+		{"WithKeyCache", WithKeyCache(func(value interface{}) uint64 { return uint64(value.(int)) }), fat},
+	} {
+		tree := New(IntSmaller, IntLarger, test.option)
+		tree.Insert(1)
+		if actual := tree.nodeBytes(); test.expected != actual {
+			t.Errorf("%s: Node size: {Expected: %d | Actual: %d}", test.name, test.expected, actual)
+		}
+	}
+}
+
+// Account for the bytes of string values
+func ExampleWithSizer() {
+	tree := Ordered[string](WithSizer(func(value interface{}) int64 {
+		return int64(len(value.(string)))
+	}))
+	tree.Insert("hello")
+	tree.Insert("world")
+	fmt.Println(tree.Profile().ValueBytes)
+	// Output:
+	// 10
+}
@@ -0,0 +1,94 @@
+package bstree
+
+import "container/heap"
+
+// _MergeIterator merges the iterators of several trees into one sorted stream
+type _MergeIterator struct {
+	compare Compare
+	heap    []_MergeSource // sources that have a current value, smallest first
+	started bool
+	value   interface{}
+}
+
+// _MergeSource is an iterator positioned at its current value
+type _MergeSource struct {
+	iterator Iterator
+	index    int // position of the tree among the merged trees, to break ties
+}
+
+// MergeIterators returns an iterator over the values of all trees in ascending order
+// The trees have to be ordered the same way; the comparators of the first
+// tree are used. It is a k-way merge of the iterators of the trees, so the
+// trees are never merged physically, which makes it possible to query
+// across per-shard trees. Values that exist in several trees are returned
+// once per tree, in the order the trees were passed. Each tree is only
+// locked during its own steps, like with Iterator.
+// Time-complexity: O(log(trees)) per step, plus the cost of the iterators
+func MergeIterators(trees ...*Tree) Iterator {
+	merge := &_MergeIterator{}
+	if len(trees) > 0 {
+		merge.compare = trees[0].compare
+	}
+	for i, tree := range trees {
+		merge.heap = append(merge.heap, _MergeSource{iterator: tree.Iterator(), index: i})
+	}
+	return merge
+}
+
+func (merge *_MergeIterator) Next() bool {
+	if !merge.started {
+		// Position every source on its first value
+		merge.started = true
+		sources := merge.heap
+		merge.heap = merge.heap[:0]
+		for _, source := range sources {
+			if source.iterator.Next() {
+				merge.heap = append(merge.heap, source)
+			}
+		}
+		heap.Init(merge)
+	} else if len(merge.heap) > 0 {
+		// Advance the source whose value was returned last
+		if merge.heap[0].iterator.Next() {
+			heap.Fix(merge, 0)
+		} else {
+			heap.Pop(merge)
+		}
+	}
+	if len(merge.heap) == 0 {
+		merge.value = nil
+		return false
+	}
+	merge.value = merge.heap[0].iterator.Value()
+	return true
+}
+
+func (merge *_MergeIterator) Value() interface{} {
+	return merge.value
+}
+
+func (merge *_MergeIterator) Len() int {
+	return len(merge.heap)
+}
+
+func (merge *_MergeIterator) Less(i int, j int) bool {
+	a, b := merge.heap[i], merge.heap[j]
+	if order := merge.compare(a.iterator.Value(), b.iterator.Value()); order != 0 {
+		return order < 0
+	}
+	return a.index < b.index
+}
+
+func (merge *_MergeIterator) Swap(i int, j int) {
+	merge.heap[i], merge.heap[j] = merge.heap[j], merge.heap[i]
+}
+
+func (merge *_MergeIterator) Push(source interface{}) {
+	merge.heap = append(merge.heap, source.(_MergeSource))
+}
+
+func (merge *_MergeIterator) Pop() interface{} {
+	last := merge.heap[len(merge.heap)-1]
+	merge.heap = merge.heap[:len(merge.heap)-1]
+	return last
+}
@@ -0,0 +1,47 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMergeIterators(t *testing.T) {
+	sharded := NewSharded(5, func(value interface{}) int { return value.(int) * 7 }, IntSmaller, IntLarger)
+	for i := 0; i < 1000; i++ {
+		sharded.Insert(i)
+	}
+	expected := 0
+	for it := MergeIterators(sharded.Shards()...); it.Next(); expected++ {
+		if expected != it.Value() {
+			t.Errorf("Value: {Expected: %d | Actual: %v}", expected, it.Value())
+		}
+	}
+	if expected != 1000 {
+		t.Errorf("Values: {Expected: 1000 | Actual: %d}", expected)
+	}
+	if MergeIterators().Next() {
+		t.Errorf("Next without trees: {Expected: false | Actual: true}")
+	}
+	if MergeIterators(EmptyTree(), EmptyTree()).Next() {
+		t.Errorf("Next of empty trees: {Expected: false | Actual: true}")
+	}
+}
+
+// Iterate over two trees in sorted order
+func ExampleMergeIterators() {
+	odd, even := New(IntSmaller, IntLarger), New(IntSmaller, IntLarger)
+	for i := 1; i <= 6; i++ {
+		if i%2 == 1 {
+			odd.Insert(i)
+		} else {
+			even.Insert(i)
+		}
+	}
+	even.Insert(3)
+	for it := MergeIterators(odd, even); it.Next(); {
+		fmt.Print(it.Value(), " ")
+	}
+	fmt.Println()
+	// Output:
+	// 1 2 3 3 4 5 6
+}
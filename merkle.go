@@ -0,0 +1,203 @@
+package bstree
+
+import "hash/fnv"
+
+// merkleLeaf is the number of values in a range below which DiffByHash compares values directly
+const merkleLeaf = 16
+
+// WithMerkle makes the tree maintain a digest of every subtree
+// encode converts a value to bytes; the digest of a subtree is the sum of
+// the digests of its values. Unlike a hash over the shape, the sum is the
+// same for equal contents however they are arranged, so the digest of any
+// range of values can be assembled in O(depth) and compared with the
+// digest of the same range in a replica of a different shape. The digests
+// are 64-bit and not cryptographic: they detect accidental divergence, not
+// deliberate collisions. encode is called O(depth) times per Insert and Delete.
+func WithMerkle(encode func(value interface{}) []byte) Option {
+	return func(tree *Tree) {
+		tree.merkle = encode
+	}
+}
+
+// valueDigest hashes the encoding of a single value
+func (tree *Tree) valueDigest(value interface{}) uint64 {
+	h := fnv.New64a()
+	h.Write(tree.merkle(value))
+	// Finalize with the mixer of splitmix64, so that sums of digests are
+	// as unlikely to collide as the digests themselves
+	digest := h.Sum64()
+	digest ^= digest >> 30
+	digest *= 0xbf58476d1ce4e5b9
+	digest ^= digest >> 27
+	digest *= 0x94d049bb133111eb
+	digest ^= digest >> 31
+	return digest
+}
+
+// digestOf returns the digest of the subtree rooted at node, which must belong to a tree created WithMerkle
+func digestOf(node *_Node) uint64 {
+	if node == nil {
+		return 0
+	}
+	return fat(node).digest
+}
+
+// DigestRange returns the digest of all values within r
+// Trees created WithMerkle using the same encoder have equal digests for
+// equal values within r. Returns 0 if the tree has no digests.
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (tree *Tree) DigestRange(r Range) uint64 {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	digest, _ := tree.summarizeRange(r)
+	return digest
+}
+
+// summarizeRange returns the digest and the number of values within r
+func (tree *Tree) summarizeRange(r Range) (uint64, int) {
+	if tree.merkle == nil {
+		return 0, tree.countRange(r)
+	}
+	upper, count := digestOf(tree.root), tree.size
+	if r.LT != nil || r.LTE != nil {
+		upper, count = tree.digestBelow(func(value interface{}) bool {
+			return !tree.tooLarge(r, value)
+		})
+	}
+	if r.GT != nil || r.GTE != nil {
+		lower, below := tree.digestBelow(func(value interface{}) bool {
+			return tree.tooSmall(r, value)
+		})
+		if count < below {
+			return 0, 0
+		}
+		upper, count = upper-lower, count-below
+	}
+	return upper, count
+}
+
+// digestBelow returns the digest and the number of values for which below holds
+// below has to hold for a prefix of the values in sorted order.
+func (tree *Tree) digestBelow(below func(value interface{}) bool) (uint64, int) {
+	var digest uint64
+	count := 0
+	node := tree.root
+	for node != nil {
+		if below(node.value) {
+			digest += tree.valueDigest(node.value) + digestOf(node.left)
+			count += sizeOf(node.left) + 1
+			node = node.right()
+		} else {
+			node = node.left
+		}
+	}
+	return digest, count
+}
+
+// DiffByHash compares the tree with other like Diff, but skips ranges whose digests match
+// Both trees have to be created WithMerkle using the same encoder. The
+// ranges are split at the median until their digests agree or only a few
+// values are left, which are then compared directly. Copies of the median
+// are compared by count, so long runs of duplicates end the split as well. For d differences
+// this takes O(d * log(size)) digest queries, each of which only locks
+// one tree, so the other tree may as well be a remote replica answering
+// DigestRange. Without digests on both trees it falls back to Diff.
+// Average case time-complexity: O(d * depth * log(size))
+func (tree *Tree) DiffByHash(other *Tree) (added []interface{}, removed []interface{}) {
+	if tree == other {
+		return nil, nil
+	}
+	if tree.merkle == nil || other.merkle == nil {
+		return tree.Diff(other)
+	}
+	tree.diffRange(other, Range{}, &added, &removed)
+	return added, removed
+}
+
+// diffRange adds the differences of the trees within r to added and removed in sorted order
+func (tree *Tree) diffRange(other *Tree, r Range, added *[]interface{}, removed *[]interface{}) {
+	tree.rlock()
+	mine, count := tree.summarizeRange(r)
+	tree.mutex.RUnlock()
+	other.rlock()
+	theirs, otherCount := other.summarizeRange(r)
+	other.mutex.RUnlock()
+	if mine == theirs && count == otherCount {
+		return
+	}
+	if count+otherCount > merkleLeaf {
+		// Split at the median of the side with more values in the range
+		median, ok := tree.rangeMedian(r)
+		if otherCount > count {
+			median, ok = other.rangeMedian(r)
+		}
+		if ok {
+			// The median gets a range of its own, so both halves shrink
+			// even if the range holds nothing but copies of one value
+			below, above := r, r
+			below.LT, below.LTE = median, nil
+			above.GT, above.GTE = median, nil
+			tree.diffRange(other, below, added, removed)
+			tree.diffEqual(other, median, added, removed)
+			tree.diffRange(other, above, added, removed)
+			return
+		}
+	}
+	a, b := tree.diffValues(tree.rangeValues(r), other.rangeValues(r))
+	*added, *removed = append(*added, a...), append(*removed, b...)
+}
+
+// diffEqual adds the differences of the trees among the values equal to value
+// Equal values are interchangeable, so only their numbers are compared.
+func (tree *Tree) diffEqual(other *Tree, value interface{}, added *[]interface{}, removed *[]interface{}) {
+	r := Range{GTE: value, LTE: value}
+	tree.rlock()
+	count := tree.countRange(r)
+	tree.mutex.RUnlock()
+	other.rlock()
+	otherCount := other.countRange(r)
+	other.mutex.RUnlock()
+	switch {
+	case count > otherCount:
+		*removed = append(*removed, surplus(tree.rangeValues(r), otherCount)...)
+	case otherCount > count:
+		*added = append(*added, surplus(other.rangeValues(r), count)...)
+	}
+}
+
+// surplus returns the values beyond the first n
+// The values are read under a different lock than n was counted under, so
+// there may be fewer of them by now.
+func surplus(values []interface{}, n int) []interface{} {
+	if n > len(values) {
+		return nil
+	}
+	return values[n:]
+}
+
+// rangeValues returns the values within r in sorted order
+func (tree *Tree) rangeValues(r Range) []interface{} {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	var values []interface{}
+	tree.doAscend(tree.root, r, func(value interface{}) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// rangeMedian returns the middle one of the values within r, if there are any
+func (tree *Tree) rangeMedian(r Range) (interface{}, bool) {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	count := tree.countRange(r)
+	if count == 0 {
+		return nil, false
+	}
+	lower := tree.countBelow(func(value interface{}) bool {
+		return tree.tooSmall(r, value)
+	})
+	return tree.selectNode(lower + count/2).value, true
+}
@@ -0,0 +1,95 @@
+package bstree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestTree_DiffByHash(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend, WithMerkle(encodeInt))
+		replica := New(IntSmaller, IntLarger, WithMerkle(encodeInt))
+		for _, value := range rand.Perm(5000) {
+			tree.Insert(value)
+		}
+		for _, value := range rand.Perm(5000) {
+			replica.Insert(value)
+		}
+		if added, removed := tree.DiffByHash(replica); len(added)+len(removed) != 0 {
+			t.Errorf("DiffByHash of equal trees: {Expected: [] [] | Actual: %v %v}", added, removed)
+		}
+		for i := 0; i < 20; i++ {
+			tree.Delete(rand.Intn(5000))
+			replica.Delete(rand.Intn(5000))
+			replica.Insert(5000 + rand.Intn(100))
+		}
+		mustCheck(t, tree)
+		mustCheck(t, replica)
+		expectedAdded, expectedRemoved := tree.Diff(replica)
+		added, removed := tree.DiffByHash(replica)
+		if fmt.Sprint(expectedAdded) != fmt.Sprint(added) {
+			t.Errorf("Added: {Expected: %v | Actual: %v}", expectedAdded, added)
+		}
+		if fmt.Sprint(expectedRemoved) != fmt.Sprint(removed) {
+			t.Errorf("Removed: {Expected: %v | Actual: %v}", expectedRemoved, removed)
+		}
+	})
+}
+
+func TestTree_DigestRange(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithMerkle(encodeInt))
+	for i := 0; i < 100; i++ {
+		tree.Insert(i)
+	}
+	r := Range{GTE: 10, LT: 20}
+	part := New(IntSmaller, IntLarger, WithMerkle(encodeInt))
+	tree.TraverseRange(r, func(value interface{}) {
+		part.Insert(value)
+	})
+	if expected, actual := part.DigestRange(Range{}), tree.DigestRange(r); expected != actual {
+		t.Errorf("DigestRange(%v): {Expected: %x | Actual: %x}", r, expected, actual)
+	}
+	if actual := tree.DigestRange(Range{GT: 50, LT: 40}); actual != 0 {
+		t.Errorf("DigestRange of empty range: {Expected: 0 | Actual: %x}", actual)
+	}
+}
+
+// Find the values two replicas disagree on
+func ExampleTree_DiffByHash() {
+	tree, replica := New(IntSmaller, IntLarger, WithMerkle(encodeInt)), New(IntSmaller, IntLarger, WithMerkle(encodeInt))
+	for i := 0; i < 1000; i++ {
+		tree.Insert(i)
+		replica.Insert(i)
+	}
+	tree.Delete(500)
+	replica.Delete(250)
+	fmt.Println(tree.DiffByHash(replica))
+	// Output:
+	// [500] [250]
+}
+
+func TestTree_DiffByHashDuplicates(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend, WithDuplicates(), WithMerkle(encodeInt))
+		replica := New(IntSmaller, IntLarger, WithDuplicates(), WithMerkle(encodeInt))
+		for i := 0; i < 20; i++ {
+			tree.Insert(7)
+		}
+		for i := 0; i < 3; i++ {
+			replica.Insert(7)
+		}
+		for i := 0; i < 10; i++ {
+			tree.Insert(i)
+			replica.Insert(i + 1)
+		}
+		expectedAdded, expectedRemoved := tree.Diff(replica)
+		added, removed := tree.DiffByHash(replica)
+		if fmt.Sprint(expectedAdded) != fmt.Sprint(added) {
+			t.Errorf("Added: {Expected: %v | Actual: %v}", expectedAdded, added)
+		}
+		if fmt.Sprint(expectedRemoved) != fmt.Sprint(removed) {
+			t.Errorf("Removed: {Expected: %v | Actual: %v}", expectedRemoved, removed)
+		}
+	})
+}
@@ -0,0 +1,112 @@
+package bstree
+
+import (
+	"net/netip"
+	"reflect"
+)
+
+// netip.Addr versions of Smaller and Larger
+// IPv4 addresses sort before IPv6 addresses, see netip.Addr.Compare.
+func AddrSmaller(value interface{}, other interface{}) bool {
+	return value.(netip.Addr).Compare(other.(netip.Addr)) < 0
+}
+
+func AddrLarger(value interface{}, other interface{}) bool {
+	return value.(netip.Addr).Compare(other.(netip.Addr)) > 0
+}
+
+// PrefixCompare orders netip.Prefix values by their first address, then by length
+// Prefixes are compared in their masked form, so 10.1.2.3/8 equals 10.0.0.0/8.
+// Shorter prefixes sort before the longer prefixes they contain.
+func PrefixCompare(value interface{}, other interface{}) int {
+	a, b := value.(netip.Prefix).Masked(), other.(netip.Prefix).Masked()
+	if result := a.Addr().Compare(b.Addr()); result != 0 {
+		return result
+	}
+	return a.Bits() - b.Bits()
+}
+
+// netip.Prefix versions of Smaller and Larger
+func PrefixSmaller(value interface{}, other interface{}) bool {
+	return PrefixCompare(value, other) < 0
+}
+
+func PrefixLarger(value interface{}, other interface{}) bool {
+	return PrefixCompare(value, other) > 0
+}
+
+// _PrefixAug is the summary of a subtree of prefixes: the largest address any of them covers
+type _PrefixAug netip.Addr
+
+// prefixAugment maintains the largest covered address of every subtree
+func prefixAugment(value interface{}, left Aug, right Aug) Aug {
+	last := lastAddr(value.(netip.Prefix))
+	for _, aug := range []Aug{left, right} {
+		if aug != nil && netip.Addr(aug.(_PrefixAug)).Compare(last) > 0 {
+			last = netip.Addr(aug.(_PrefixAug))
+		}
+	}
+	return _PrefixAug(last)
+}
+
+// lastAddr returns the largest address covered by a prefix
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	prefix = prefix.Masked()
+	bytes := prefix.Addr().AsSlice()
+	for bit := prefix.Bits(); bit < len(bytes)*8; bit++ {
+		bytes[bit/8] |= 0x80 >> (bit % 8)
+	}
+	last, _ := netip.AddrFromSlice(bytes)
+	return last
+}
+
+// NewPrefixTree creates an initialized tree of netip.Prefix values indexed for CoveringPrefix
+// Values other than netip.Prefix are rejected as if created WithType.
+// Passing WithAugment replaces the index, which makes CoveringPrefix scan the whole tree.
+// Time-complexity: O(1)
+func NewPrefixTree(options ...Option) *Tree {
+	options = append([]Option{WithType(reflect.TypeFor[netip.Prefix]()), WithAugment(prefixAugment)}, options...)
+	return newTree(PrefixSmaller, PrefixLarger, PrefixCompare, options...)
+}
+
+// CoveringPrefix returns the most specific stored prefix containing addr
+// It implements the longest prefix match of routing tables and ACLs.
+// The tree has to hold netip.Prefix values, ordered by PrefixSmaller and
+// PrefixLarger. Trees created by NewPrefixTree skip subtrees that cannot
+// contain addr; other trees are scanned in full.
+// Average case time-complexity: O(depth + matching prefixes) for trees created by NewPrefixTree
+// Worst case time-complexity: O(size)
+func (tree *Tree) CoveringPrefix(addr netip.Addr) (netip.Prefix, bool) {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	var best netip.Prefix
+	found := false
+	tree.doCoveringPrefix(tree.root, addr, func(prefix netip.Prefix) {
+		if !found || prefix.Bits() > best.Bits() {
+			best, found = prefix, true
+		}
+	})
+	return best, found
+}
+
+// doCoveringPrefix calls visitor on every prefix of the subtree that contains addr
+func (tree *Tree) doCoveringPrefix(node *_Node, addr netip.Addr, visitor func(prefix netip.Prefix)) {
+	if node == nil {
+		return
+	}
+	if tree.augment != nil {
+		if aug, ok := augOf(node).(_PrefixAug); ok && netip.Addr(aug).Compare(addr) < 0 {
+			return
+		}
+	}
+	tree.doCoveringPrefix(node.left, addr, visitor)
+	prefix := node.value.(netip.Prefix)
+	if prefix.Masked().Addr().Compare(addr) > 0 {
+		// Everything to the right starts after addr
+		return
+	}
+	if prefix.Contains(addr) {
+		visitor(prefix)
+	}
+	tree.doCoveringPrefix(node.right(), addr, visitor)
+}
@@ -0,0 +1,70 @@
+package bstree
+
+import (
+	"fmt"
+	"math/rand"
+	"net/netip"
+	"testing"
+)
+
+func TestTree_CoveringPrefix(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	indexed := NewPrefixTree()
+	plain := New(PrefixSmaller, PrefixLarger)
+	var prefixes []netip.Prefix
+	for i := 0; i < 300; i++ {
+		addr := netip.AddrFrom4([4]byte{10, byte(rng.Intn(4)), byte(rng.Intn(256)), 0})
+		prefix := netip.PrefixFrom(addr, 8+rng.Intn(17)).Masked()
+		prefixes = append(prefixes, prefix)
+		indexed.Insert(prefix)
+		plain.Insert(prefix)
+	}
+	indexed.Insert(netip.MustParsePrefix("2001:db8::/32"))
+	for i := 0; i < 1000; i++ {
+		addr := netip.AddrFrom4([4]byte{byte(9 + rng.Intn(2)), byte(rng.Intn(4)), byte(rng.Intn(256)), byte(rng.Intn(256))})
+		var expected netip.Prefix
+		found := false
+		for _, prefix := range prefixes {
+			if prefix.Contains(addr) && (!found || prefix.Bits() > expected.Bits()) {
+				expected, found = prefix, true
+			}
+		}
+		for _, tree := range []*Tree{indexed, plain} {
+			actual, ok := tree.CoveringPrefix(addr)
+			if ok != found || actual != expected {
+				t.Fatalf("CoveringPrefix(%v): {Expected: %v %t | Actual: %v %t}", addr, expected, found, actual, ok)
+			}
+		}
+	}
+	if prefix, ok := indexed.CoveringPrefix(netip.MustParseAddr("2001:db8::1")); !ok || prefix.Bits() != 32 {
+		t.Errorf("CoveringPrefix(2001:db8::1): {Expected: 2001:db8::/32 true | Actual: %v %t}", prefix, ok)
+	}
+}
+
+func TestAddrComparators(t *testing.T) {
+	tree := New(AddrSmaller, AddrLarger)
+	for _, s := range []string{"::1", "10.0.0.2", "10.0.0.10", "192.168.0.1"} {
+		tree.Insert(netip.MustParseAddr(s))
+	}
+	if expected := "10.0.0.2"; tree.Minimum().(netip.Addr).String() != expected {
+		t.Errorf("Minimum: {Expected: %s | Actual: %v}", expected, tree.Minimum())
+	}
+	if expected := "::1"; tree.Maximum().(netip.Addr).String() != expected {
+		t.Errorf("Maximum: {Expected: %s | Actual: %v}", expected, tree.Maximum())
+	}
+}
+
+// Look up the most specific route of an address
+func ExampleTree_CoveringPrefix() {
+	routes := NewPrefixTree()
+	for _, s := range []string{"0.0.0.0/0", "10.0.0.0/8", "10.1.0.0/16", "192.168.1.0/24"} {
+		routes.Insert(netip.MustParsePrefix(s))
+	}
+	for _, s := range []string{"10.1.2.3", "10.2.0.1", "8.8.8.8"} {
+		fmt.Println(routes.CoveringPrefix(netip.MustParseAddr(s)))
+	}
+	// Output:
+	// 10.1.0.0/16 true
+	// 10.0.0.0/8 true
+	// 0.0.0.0/0 true
+}
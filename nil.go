@@ -0,0 +1,82 @@
+package bstree
+
+import "errors"
+
+// ErrNilValue is returned for nil values a tree created WithNilPolicy(RejectNil) does not accept
+var ErrNilValue = errors.New("bstree: nil value")
+
+// NilPolicy decides how a tree treats nil values
+type NilPolicy int32
+
+const (
+	// NilUnchecked passes nil values to the comparators, which usually panic
+	NilUnchecked NilPolicy = iota
+	// RejectNil refuses nil values before they reach the comparators
+	RejectNil
+	// NilFirst orders nil before every other value
+	NilFirst
+	// NilLast orders nil after every other value
+	NilLast
+)
+
+// WithNilPolicy selects how the tree treats nil values
+// With RejectNil, Insert, Exists and Delete return false for nil and the
+// E variants return ErrNilValue. With NilFirst and NilLast a single nil
+// can be stored and found like any other value, even in trees created
+// WithType; the comparators never see it. Only an untyped nil counts as
+// nil, not a nil pointer stored in an interface.
+// The default is NilUnchecked.
+func WithNilPolicy(policy NilPolicy) Option {
+	return func(tree *Tree) {
+		tree.nilPolicy = policy
+		if policy != NilFirst && policy != NilLast {
+			return
+		}
+		// nil is smaller than everything for NilFirst, larger for NilLast
+		first := policy == NilFirst
+		smaller, larger, compare := tree.smaller, tree.larger, tree.compare
+		tree.smaller = func(value interface{}, other interface{}) bool {
+			if value == nil || other == nil {
+				return (other != nil && first) || (value != nil && !first)
+			}
+			return smaller(value, other)
+		}
+		tree.larger = func(value interface{}, other interface{}) bool {
+			if value == nil || other == nil {
+				return (value != nil && first) || (other != nil && !first)
+			}
+			return larger(value, other)
+		}
+		// nilOrder is the result of comparing nil with any other value
+		nilOrder := 1
+		if first {
+			nilOrder = -1
+		}
+		tree.compare = func(value interface{}, other interface{}) int {
+			switch {
+			case value == nil && other == nil:
+				return 0
+			case value == nil:
+				return nilOrder
+			case other == nil:
+				return -nilOrder
+			}
+			return compare(value, other)
+		}
+	}
+}
+
+// checkNil returns an error if value is a nil the tree does not accept
+// accepted reports whether value is a nil the tree orders itself.
+func (tree *Tree) checkNil(value interface{}) (accepted bool, err error) {
+	if value != nil {
+		return false, nil
+	}
+	switch tree.nilPolicy {
+	case RejectNil:
+		return false, ErrNilValue
+	case NilFirst, NilLast:
+		return true, nil
+	}
+	return false, nil
+}
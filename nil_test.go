@@ -0,0 +1,60 @@
+package bstree
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestTree_RejectNil(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithNilPolicy(RejectNil))
+	if tree.Insert(nil) {
+		t.Errorf("Insert(nil): {Expected: false | Actual: true}")
+	}
+	if err := tree.InsertE(nil); !errors.Is(err, ErrNilValue) {
+		t.Errorf("InsertE(nil): {Expected: %v | Actual: %v}", ErrNilValue, err)
+	}
+	tree.Insert(1)
+	if tree.Exists(nil) || tree.Delete(nil) {
+		t.Errorf("Exists/Delete(nil): {Expected: false | Actual: true}")
+	}
+}
+
+func TestTree_NilOrdering(t *testing.T) {
+	tests := []struct {
+		policy  NilPolicy
+		minimum interface{}
+		maximum interface{}
+	}{
+		{NilFirst, nil, 9},
+		{NilLast, 0, nil},
+	}
+	for _, test := range tests {
+		tree := New(IntSmaller, IntLarger, WithNilPolicy(test.policy), WithType(reflect.TypeOf(0)))
+		for i := 0; i < 10; i++ {
+			tree.Insert(i)
+		}
+		if !tree.Insert(nil) || tree.Insert(nil) {
+			t.Errorf("Insert(nil) twice: {Expected: true false}")
+		}
+		if tree.Minimum() != test.minimum || tree.Maximum() != test.maximum {
+			t.Errorf("MinMax: {Expected: %v,%v | Actual: %v,%v}", test.minimum, test.maximum, tree.Minimum(), tree.Maximum())
+		}
+		mustCheck(t, tree)
+		if !tree.Delete(nil) || tree.Exists(nil) {
+			t.Errorf("Delete(nil): {Expected: true | Actual: false}")
+		}
+	}
+}
+
+// Store nil as the smallest value
+func ExampleWithNilPolicy() {
+	tree := New(IntSmaller, IntLarger, WithNilPolicy(NilFirst))
+	tree.Insert(2)
+	tree.Insert(nil)
+	tree.Insert(1)
+	fmt.Println(tree)
+	// Output:
+	// {size: 3 | depth: 3 | min: <nil> | max: 2 | values: [<nil> 1 2]}
+}
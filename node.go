@@ -0,0 +1,55 @@
+package bstree
+
+// Node is a read-only view of a node of a tree
+// It is obtained through ReadView.Root and, like the view, only valid
+// during the callback of View. It exposes the shape of the tree, so custom
+// algorithms can walk it without reaching into the package. The zero Node
+// stands for a missing child.
+type Node struct {
+	node *_Node
+}
+
+// IsNil reports whether the node is missing, e.g. the child of a leaf
+// Time-complexity: O(1)
+func (n Node) IsNil() bool {
+	return n.node == nil
+}
+
+// Value returns the value held by the node, or nil for a missing node
+// Time-complexity: O(1)
+func (n Node) Value() interface{} {
+	if n.node == nil {
+		return nil
+	}
+	return n.node.value
+}
+
+// Left returns the left child of the node, which may be missing
+// Time-complexity: O(1)
+func (n Node) Left() Node {
+	if n.node == nil {
+		return Node{}
+	}
+	return Node{n.node.left}
+}
+
+// Right returns the right child of the node, which may be missing
+// Time-complexity: O(1)
+func (n Node) Right() Node {
+	if n.node == nil {
+		return Node{}
+	}
+	return Node{n.node.right()}
+}
+
+// IsLeaf reports whether the node exists and has no children
+// Time-complexity: O(1)
+func (n Node) IsLeaf() bool {
+	return n.node != nil && n.node.left == nil && n.node.right() == nil
+}
+
+// Size returns the number of nodes in the subtree rooted at the node
+// Time-complexity: O(1)
+func (n Node) Size() int {
+	return sizeOf(n.node)
+}
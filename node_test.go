@@ -0,0 +1,59 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+// height computes the number of levels below n using only the public Node API
+func height(n Node) int {
+	if n.IsNil() {
+		return 0
+	}
+	return 1 + max(height(n.Left()), height(n.Right()))
+}
+
+func TestReadView_Root(t *testing.T) {
+	tree := RandomTree(500, 1000)
+	depth, leafCount := tree.Depth(), tree.Profile().Leaves
+	tree.View(func(view ReadView) {
+		root := view.Root()
+		if expected := depth; expected != height(root) {
+			t.Errorf("Height: {Expected: %d | Actual: %d}", expected, height(root))
+		}
+		if expected := view.Size(); expected != root.Size() {
+			t.Errorf("Size: {Expected: %d | Actual: %d}", expected, root.Size())
+		}
+		leaves := 0
+		var walk func(n Node)
+		walk = func(n Node) {
+			if n.IsLeaf() {
+				leaves++
+			}
+			if !n.IsNil() {
+				walk(n.Left())
+				walk(n.Right())
+			}
+		}
+		walk(root)
+		if expected := leafCount; expected != leaves {
+			t.Errorf("Leaves: {Expected: %d | Actual: %d}", expected, leaves)
+		}
+	})
+	EmptyTree().View(func(view ReadView) {
+		if root := view.Root(); !root.IsNil() || root.Value() != nil || !root.Left().IsNil() || root.IsLeaf() {
+			t.Errorf("Root of empty tree: {Expected: nil node | Actual: %v}", root.Value())
+		}
+	})
+}
+
+// Walk the shape of a tree
+func ExampleNode() {
+	tree := CompleteTree(3)
+	tree.View(func(view ReadView) {
+		root := view.Root()
+		fmt.Println(root.Value(), root.Left().Value(), root.Right().Value(), root.Left().IsLeaf())
+	})
+	// Output:
+	// 2 1 3 true
+}
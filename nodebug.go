@@ -0,0 +1,6 @@
+//go:build !bstree_debug
+
+package bstree
+
+// debug is only set in builds with the bstree_debug tag, see debug.go
+const debug = false
@@ -0,0 +1,70 @@
+package bstree
+
+// NodeVisitor is called with a value and its position in the tree
+// depth is 0 for the root; isLeaf is true for nodes without children.
+type NodeVisitor func(value interface{}, depth int, isLeaf bool)
+
+// TraverseNodes walks the tree like Traverse, passing structural context to visitor
+// Time-complexity: O(size)
+func (tree *Tree) TraverseNodes(traversal Traversal, visitor NodeVisitor) {
+	defer tree.recoverPanic()
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	switch traversal {
+	case PreOrder, InOrder, PostOrder:
+		tree.doTraverseNodes(tree.root, 0, traversal, visitor)
+	case LevelOrder:
+		tree.doLevelOrderNodes(visitor)
+	case ZigZagOrder:
+		tree.doZigZag(func(node *_Node, depth int) {
+			visitor(node.value, depth, node.left == nil && node.right() == nil)
+		})
+	case BoundaryOrder:
+		tree.doBoundary(func(node *_Node, depth int) {
+			visitor(node.value, depth, node.left == nil && node.right() == nil)
+		})
+	}
+}
+
+func (tree *Tree) doTraverseNodes(node *_Node, depth int, traversal Traversal, visitor NodeVisitor) {
+	if node == nil {
+		return
+	}
+	isLeaf := node.left == nil && node.right() == nil
+	if traversal == PreOrder {
+		visitor(node.value, depth, isLeaf)
+	}
+	tree.doTraverseNodes(node.left, depth+1, traversal, visitor)
+	if traversal == InOrder {
+		visitor(node.value, depth, isLeaf)
+	}
+	tree.doTraverseNodes(node.right(), depth+1, traversal, visitor)
+	if traversal == PostOrder {
+		visitor(node.value, depth, isLeaf)
+	}
+}
+
+// _LevelEntry is a node queued for a level-order walk together with its depth
+type _LevelEntry struct {
+	node  *_Node
+	depth int
+}
+
+func (tree *Tree) doLevelOrderNodes(visitor NodeVisitor) {
+	if tree.root == nil {
+		return
+	}
+	var queue _Ring[_LevelEntry]
+	queue.push(_LevelEntry{tree.root, 0})
+	for queue.len() > 0 {
+		entry := queue.pop()
+		node := entry.node
+		visitor(node.value, entry.depth, node.left == nil && node.right() == nil)
+		if node.left != nil {
+			queue.push(_LevelEntry{node.left, entry.depth + 1})
+		}
+		if node.right() != nil {
+			queue.push(_LevelEntry{node.right(), entry.depth + 1})
+		}
+	}
+}
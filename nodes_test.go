@@ -0,0 +1,56 @@
+package bstree
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestTree_TraverseNodes(t *testing.T) {
+	tree := RandomTree(300, 1000)
+	for _, traversal := range []Traversal{PreOrder, InOrder, PostOrder, LevelOrder} {
+		var expected, actual []interface{}
+		tree.Traverse(traversal, func(value interface{}) {
+			expected = append(expected, value)
+		})
+		maxDepth, leaves := 0, 0
+		tree.TraverseNodes(traversal, func(value interface{}, depth int, isLeaf bool) {
+			actual = append(actual, value)
+			if depth > maxDepth {
+				maxDepth = depth
+			}
+			if isLeaf {
+				leaves++
+			}
+		})
+		if fmt.Sprint(expected) != fmt.Sprint(actual) {
+			t.Errorf("TraverseNodes(%d) order: {Expected: %v | Actual: %v}", traversal, expected, actual)
+		}
+		if tree.Depth()-1 != maxDepth {
+			t.Errorf("TraverseNodes(%d) depth: {Expected: %d | Actual: %d}", traversal, tree.Depth()-1, maxDepth)
+		}
+		if leaves == 0 {
+			t.Errorf("TraverseNodes(%d) leaves: {Expected: > 0 | Actual: 0}", traversal)
+		}
+	}
+}
+
+// Print the tree with indentation
+func ExampleTree_TraverseNodes() {
+	tree := CompleteTree(7)
+	tree.TraverseNodes(PreOrder, func(value interface{}, depth int, isLeaf bool) {
+		marker := ""
+		if isLeaf {
+			marker = " (leaf)"
+		}
+		fmt.Printf("%s%d%s\n", strings.Repeat("  ", depth), value, marker)
+	})
+	// Output:
+	// 4
+	//   2
+	//     1 (leaf)
+	//     3 (leaf)
+	//   6
+	//     5 (leaf)
+	//     7 (leaf)
+}
@@ -0,0 +1,25 @@
+package bstree
+
+import (
+	"cmp"
+	"reflect"
+)
+
+// Ordered versions of Smaller and Larger for any type supporting the < operator
+// Instantiate them with the dynamic type of the values, e.g. OrderedSmaller[string].
+func OrderedSmaller[T cmp.Ordered](value interface{}, other interface{}) bool {
+	return cmp.Less(value.(T), other.(T))
+}
+
+func OrderedLarger[T cmp.Ordered](value interface{}, other interface{}) bool {
+	return cmp.Less(other.(T), value.(T))
+}
+
+// Ordered creates an initialized tree of values of an ordered type T
+// It needs no comparators, e.g. bstree.Ordered[string](), and rejects
+// values of other types as if created WithType.
+// Time-complexity: O(1)
+func Ordered[T cmp.Ordered](options ...Option) *Tree {
+	options = append([]Option{WithType(reflect.TypeFor[T]())}, options...)
+	return newTree(OrderedSmaller[T], OrderedLarger[T], OrderedCompare[T], options...)
+}
@@ -0,0 +1,37 @@
+package bstree
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestOrdered_Float(t *testing.T) {
+	tree := Ordered[float64]()
+	for _, value := range []float64{2.5, -1, math.Inf(1), 0, 2.5} {
+		tree.Insert(value)
+	}
+	if expected := 4; expected != tree.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+	}
+	if actual := tree.Minimum(); -1.0 != actual {
+		t.Errorf("Minimum: {Expected: -1 | Actual: %v}", actual)
+	}
+	if actual := tree.Maximum(); math.Inf(1) != actual {
+		t.Errorf("Maximum: {Expected: +Inf | Actual: %v}", actual)
+	}
+}
+
+// Create a tree of strings without passing comparators
+func ExampleOrdered() {
+	tree := Ordered[string]()
+	tree.Insert("pear")
+	tree.Insert("apple")
+	tree.Insert("fig")
+	tree.Traverse(InOrder, func(value interface{}) {
+		fmt.Printf("%s,", value)
+	})
+	fmt.Printf("\n")
+	// Output:
+	// apple,fig,pear,
+}
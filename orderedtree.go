@@ -0,0 +1,40 @@
+package bstree
+
+// OrderedTree is the method set shared by ordered trees, of which *Tree is one implementation
+// Applications programming against OrderedTree can swap in a balanced,
+// persistent or remote variant without code changes; the bstreetest
+// package checks that such a variant behaves like a *Tree. It only holds
+// methods that don't depend on how the tree is built, so configuration,
+// persistence and methods returning new *Tree values are left out.
+type OrderedTree interface {
+	// Insert adds value, returning false if an equal value exists and duplicates aren't allowed
+	Insert(value interface{}) bool
+	// Delete removes a value equal to value, returning false if there is none
+	Delete(value interface{}) bool
+	// Exists checks if a value equal to value exists
+	Exists(value interface{}) bool
+	// Size returns the number of values
+	Size() int
+	// Depth returns the number of levels
+	Depth() int
+	// Minimum returns the smallest value, or nil if there are no values
+	Minimum() interface{}
+	// Maximum returns the largest value, or nil if there are no values
+	Maximum() interface{}
+	// MinimumAtLeast returns the smallest value larger than or equal to lo
+	MinimumAtLeast(lo interface{}) (interface{}, bool)
+	// MaximumAtMost returns the largest value smaller than or equal to hi
+	MaximumAtMost(hi interface{}) (interface{}, bool)
+	// CountRange returns the number of values within r
+	CountRange(r Range) int
+	// Traverse calls visitor on each value in the order of traversal
+	Traverse(traversal Traversal, visitor Visitor)
+	// TraverseRange calls visitor in sorted order on each value within r
+	TraverseRange(r Range, visitor Visitor)
+	// Iterator returns an iterator over the values in sorted order
+	Iterator() Iterator
+	// String summarizes the values
+	String() string
+}
+
+var _ OrderedTree = (*Tree)(nil)
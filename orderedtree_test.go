@@ -0,0 +1,43 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+// topN returns the n largest values of any ordered tree, largest first
+func topN(tree OrderedTree, n int) []interface{} {
+	var values []interface{}
+	for value, ok := tree.Maximum(), tree.Size() > 0; ok && len(values) < n; {
+		values = append(values, value)
+		below := value.(int) - 1
+		value, ok = tree.MaximumAtMost(below)
+	}
+	return values
+}
+
+func TestOrderedTree(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		var tree OrderedTree = New(IntSmaller, IntLarger, backend)
+		for _, value := range Values(CompleteTree(100)) {
+			tree.Insert(value)
+		}
+		if expected, actual := "[100 99 98]", fmt.Sprint(topN(tree, 3)); expected != actual {
+			t.Errorf("topN: {Expected: %s | Actual: %s}", expected, actual)
+		}
+		if expected, actual := 10, tree.CountRange(Range{GT: 90}); expected != actual {
+			t.Errorf("CountRange: {Expected: %d | Actual: %d}", expected, actual)
+		}
+	})
+}
+
+// Program against the interface instead of the concrete tree
+func ExampleOrderedTree() {
+	var tree OrderedTree = New(IntSmaller, IntLarger, WithBalancing(LLRB))
+	for _, value := range []int{5, 2, 8, 1, 9, 3} {
+		tree.Insert(value)
+	}
+	fmt.Println(topN(tree, 2))
+	// Output:
+	// [9 8]
+}
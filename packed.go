@@ -0,0 +1,115 @@
+package bstree
+
+// Packed is an immutable copy of a tree storing several values per node
+// Each node is a small sorted array of up to fanout values with fanout+1
+// children, laid out implicitly in a single slice like a static B-tree.
+// This trades pointer chasing for cache locality and removes the per-value
+// node overhead, which pays off for very large, static indexes.
+// A Packed tree needs no locking and is safe for use by concurrent goroutines.
+type Packed struct {
+	values  []interface{} // node k holds values[k*fanout : k*fanout+lengths[k]]
+	lengths []int
+	fanout  int
+	size    int
+	smaller Smaller
+	larger  Larger
+}
+
+// Pack creates a read-optimized copy of the tree with fanout values per node
+// A fanout below 1 is treated as 1, which degenerates to a binary tree.
+// Time-complexity: O(size)
+func (tree *Tree) Pack(fanout int) *Packed {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	if fanout < 1 {
+		fanout = 1
+	}
+	nodes := (tree.size + fanout - 1) / fanout
+	packed := &Packed{
+		values:  make([]interface{}, nodes*fanout),
+		lengths: make([]int, nodes),
+		fanout:  fanout,
+		size:    tree.size,
+		smaller: tree.smaller,
+		larger:  tree.larger,
+	}
+	packed.doPack(tree.values(), new(int), 0)
+	return packed
+}
+
+// child returns the index of the i-th child of node k
+func (packed *Packed) child(k int, i int) int {
+	return k*(packed.fanout+1) + i + 1
+}
+
+// doPack fills the nodes with the sorted values by in-order walking the implicit tree
+// Since values run out at the end of the walk, unused slots are always a suffix of a node.
+func (packed *Packed) doPack(sorted []interface{}, next *int, k int) {
+	if k >= len(packed.lengths) {
+		return
+	}
+	for i := 0; i < packed.fanout; i++ {
+		packed.doPack(sorted, next, packed.child(k, i))
+		if *next < len(sorted) {
+			packed.values[k*packed.fanout+i] = sorted[*next]
+			packed.lengths[k]++
+			*next++
+		}
+	}
+	packed.doPack(sorted, next, packed.child(k, packed.fanout))
+}
+
+// Unpack creates a regular, balanced tree from the packed values
+// Time-complexity: O(size)
+func (packed *Packed) Unpack(options ...Option) *Tree {
+	tree := New(packed.smaller, packed.larger, options...)
+	sorted := make([]interface{}, 0, packed.size)
+	packed.doInOrder(0, func(value interface{}) {
+		sorted = append(sorted, tree.own(value))
+	})
+	tree.mutex.Lock()
+	tree.load(sorted)
+	tree.mutex.Unlock()
+	return tree
+}
+
+func (packed *Packed) doInOrder(k int, visitor Visitor) {
+	if k >= len(packed.lengths) {
+		return
+	}
+	for i := 0; i < packed.lengths[k]; i++ {
+		packed.doInOrder(packed.child(k, i), visitor)
+		visitor(packed.values[k*packed.fanout+i])
+	}
+	packed.doInOrder(packed.child(k, packed.lengths[k]), visitor)
+}
+
+// Size returns the number of values in the packed tree
+// Time-complexity: O(1)
+func (packed *Packed) Size() int {
+	return packed.size
+}
+
+// Exists checks if a value exists in the packed tree
+// Time-complexity: O(fanout * log(size) / log(fanout))
+func (packed *Packed) Exists(value interface{}) bool {
+	k := 0
+	for k < len(packed.lengths) {
+		node := packed.values[k*packed.fanout : k*packed.fanout+packed.lengths[k]]
+		i := 0
+		for i < len(node) && packed.smaller(node[i], value) {
+			i++
+		}
+		if i < len(node) && !packed.larger(node[i], value) {
+			return true
+		}
+		k = packed.child(k, i)
+	}
+	return false
+}
+
+// Traverse calls visitor on each value of the packed tree in sorted order
+// Time-complexity: O(size)
+func (packed *Packed) Traverse(visitor Visitor) {
+	packed.doInOrder(0, visitor)
+}
@@ -0,0 +1,58 @@
+package bstree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestPacked_Exists(t *testing.T) {
+	for _, fanout := range []int{0, 1, 2, 7, 16} {
+		for count := 0; count <= 100; count += 9 {
+			tree := RandomTree(count, 200)
+			packed := tree.Pack(fanout)
+			if count != packed.Size() {
+				t.Errorf("Size: {Expected: %d | Actual: %d}", count, packed.Size())
+			}
+			for i := -1; i <= 200; i++ {
+				if tree.Exists(i) != packed.Exists(i) {
+					t.Errorf("Exists(%d) with fanout %d: {Expected: %t | Actual: %t}", i, fanout, tree.Exists(i), packed.Exists(i))
+				}
+			}
+		}
+	}
+}
+
+func TestPacked_Unpack(t *testing.T) {
+	tree := RandomTree(1000, 5000)
+	unpacked := tree.Pack(8).Unpack()
+	if tree.Size() != unpacked.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", tree.Size(), unpacked.Size())
+	}
+	tree.Traverse(InOrder, func(value interface{}) {
+		if !unpacked.Exists(value) {
+			t.Errorf("Exists(%d): {Expected: true | Actual: false}", value)
+		}
+	})
+}
+
+// Pack a tree into nodes of four values and iterate it in sorted order
+func ExamplePacked_Traverse() {
+	packed := CompleteTree(10).Pack(4)
+	packed.Traverse(func(value interface{}) {
+		fmt.Printf("%d,", value)
+	})
+	fmt.Printf("\n")
+	// Output:
+	// 1,2,3,4,5,6,7,8,9,10,
+}
+
+// Benchmark lookups on a packed tree
+func BenchmarkPackedExists(b *testing.B) {
+	b.StopTimer()
+	packed := RandomTree(100000, 1000000).Pack(8)
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		packed.Exists(rand.Intn(1000000))
+	}
+}
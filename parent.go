@@ -0,0 +1,43 @@
+package bstree
+
+// WithParentPointers makes every node point to its parent
+// Iterators then step to the next value by following the pointers instead
+// of keeping a stack of the path, which makes each step O(1) amortized
+// without allocating. The pointers live in the larger nodes that trees
+// with per-node metadata use, and keeping them up to date adds a little
+// work to every structural change.
+func WithParentPointers() Option {
+	return func(tree *Tree) {
+		tree.parents = true
+	}
+}
+
+// adopt points the children of node back to it
+func (tree *Tree) adopt(node *_Node) {
+	if node.left != nil {
+		fat(node.left).parent = node
+	}
+	if right := node.right(); right != nil {
+		fat(right).parent = node
+	}
+}
+
+// orphanRoot clears the parent of the root, which may be stale after the root changed
+func (tree *Tree) orphanRoot() {
+	if tree.parents && tree.root != nil {
+		fat(tree.root).parent = nil
+	}
+}
+
+// successor returns the node holding the next value in order, or nil
+// It needs parent pointers.
+// Time-complexity: O(1) amortized over a full traversal
+func successor(node *_Node) *_Node {
+	if node.right() != nil {
+		return leftmost(node.right())
+	}
+	for parent := fat(node).parent; parent != nil && parent.right() == node; parent = fat(node).parent {
+		node = parent
+	}
+	return fat(node).parent
+}
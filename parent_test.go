@@ -0,0 +1,60 @@
+package bstree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestWithParentPointers(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend, WithParentPointers())
+		for _, value := range rand.Perm(1000) {
+			tree.Insert(value)
+		}
+		for i := 0; i < 500; i++ {
+			tree.Delete(rand.Intn(1000))
+		}
+		mustCheck(t, tree)
+		expected := Values(tree)
+		var actual []interface{}
+		for it := tree.Iterator(); it.Next(); {
+			actual = append(actual, it.Value())
+		}
+		if fmt.Sprint(expected) != fmt.Sprint(actual) {
+			t.Errorf("Iterator: {Expected: %v | Actual: %v}", expected, actual)
+		}
+		tree.DeleteRange(Range{GTE: 100, LT: 900})
+		mustCheck(t, tree)
+	})
+}
+
+func TestWithParentPointers_IteratorModified(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithParentPointers())
+	for i := 1; i <= 100; i++ {
+		tree.Insert(i)
+	}
+	count := 0
+	for it := tree.Iterator(); it.Next(); count++ {
+		// Delete the next value, so every other value is visited
+		tree.Delete(it.Value().(int) + 1)
+	}
+	if expected := 50; expected != count {
+		t.Errorf("Visited: {Expected: %d | Actual: %d}", expected, count)
+	}
+	mustCheck(t, tree)
+}
+
+// Iterate by following parent pointers
+func ExampleWithParentPointers() {
+	tree := New(IntSmaller, IntLarger, WithParentPointers())
+	for _, value := range []int{4, 2, 6, 1, 3, 5, 7} {
+		tree.Insert(value)
+	}
+	for it := tree.Iterator(); it.Next(); {
+		fmt.Print(it.Value(), ",")
+	}
+	fmt.Println()
+	// Output:
+	// 1,2,3,4,5,6,7,
+}
@@ -0,0 +1,26 @@
+package bstree
+
+// Partition splits the values of the tree into those for which pred returns true and the rest
+// Both new trees share the settings of the tree like with Filter, and are
+// bulk-built balanced from a single in-order pass. The tree is unchanged.
+// Time-complexity: O(size)
+func (tree *Tree) Partition(pred func(value interface{}) bool) (match *Tree, rest *Tree) {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	var matching, remaining []interface{}
+	tree.doInOrder(tree.root, func(value interface{}) {
+		if pred(value) {
+			matching = append(matching, value)
+		} else {
+			remaining = append(remaining, value)
+		}
+	})
+	match, rest = tree.sibling(), tree.sibling()
+	match.mutex.Lock()
+	match.load(matching)
+	match.mutex.Unlock()
+	rest.mutex.Lock()
+	rest.load(remaining)
+	rest.mutex.Unlock()
+	return match, rest
+}
@@ -0,0 +1,42 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_Partition(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend)
+		for _, value := range Values(RandomTree(1000, 5000)) {
+			tree.Insert(value)
+		}
+		even, odd := tree.Partition(func(value interface{}) bool {
+			return value.(int)%2 == 0
+		})
+		mustCheck(t, even)
+		mustCheck(t, odd)
+		if expected := tree.Size(); expected != even.Size()+odd.Size() {
+			t.Errorf("Size: {Expected: %d | Actual: %d + %d}", expected, even.Size(), odd.Size())
+		}
+		tree.Traverse(InOrder, func(value interface{}) {
+			inEven, inOdd := even.Exists(value), odd.Exists(value)
+			if expected := value.(int)%2 == 0; inEven != expected || inOdd == expected {
+				t.Errorf("Exists(%v): {Expected: %t %t | Actual: %t %t}", value, expected, !expected, inEven, inOdd)
+			}
+		})
+	})
+}
+
+// Split a tree into the values matching a predicate and the rest
+func ExampleTree_Partition() {
+	tree := CompleteTree(10)
+	small, large := tree.Partition(func(value interface{}) bool {
+		return value.(int) <= 3
+	})
+	fmt.Println(small)
+	fmt.Println(large)
+	// Output:
+	// {size: 3 | depth: 2 | min: 1 | max: 3 | values: [1 2 3]}
+	// {size: 7 | depth: 3 | min: 4 | max: 10 | values: [4 5 6 7 8 9 10]}
+}
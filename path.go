@@ -0,0 +1,32 @@
+package bstree
+
+// PathTo returns the values on the path from the root down to the node holding value
+// The path starts with the root and ends with the stored value equal to
+// value. A long path shows why a value lives deep in a lopsided tree.
+// Returns false if value doesn't exist. Trees created WithDuplicates
+// use the topmost equal value.
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (tree *Tree) PathTo(value interface{}) ([]interface{}, bool) {
+	defer tree.recoverPanic()
+	if tree.checkType(value) != nil {
+		return nil, false
+	}
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	var path []interface{}
+	pre := tree.preKey(value)
+	node := tree.root
+	for node != nil {
+		path = append(path, node.value)
+		switch order := tree.order(value, pre, node); {
+		case order < 0:
+			node = node.left
+		case order > 0:
+			node = node.right()
+		default:
+			return path, true
+		}
+	}
+	return nil, false
+}
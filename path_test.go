@@ -0,0 +1,36 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_PathTo(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend)
+		for i := 0; i < 100; i++ {
+			tree.Insert(i)
+		}
+		for i := 0; i < 100; i++ {
+			path, ok := tree.PathTo(i)
+			if !ok || path[len(path)-1] != i {
+				t.Fatalf("PathTo(%d): {Expected: path ending in %d | Actual: %v %t}", i, i, path, ok)
+			}
+			distance, _ := tree.Distance(path[0], i)
+			if expected := distance + 1; expected != len(path) {
+				t.Errorf("len(PathTo(%d)): {Expected: %d | Actual: %d}", i, expected, len(path))
+			}
+		}
+		if path, ok := tree.PathTo(100); ok || path != nil {
+			t.Errorf("PathTo(100): {Expected: [] false | Actual: %v %t}", path, ok)
+		}
+	})
+}
+
+// Show how to reach a value from the root
+func ExampleTree_PathTo() {
+	tree := CompleteTree(7)
+	fmt.Println(tree.PathTo(5))
+	// Output:
+	// [4 6 5] true
+}
@@ -0,0 +1,32 @@
+package bstree
+
+import "strings"
+
+// TraversePrefix calls visitor in sorted order on each string value starting with prefix
+// The tree must hold string values ordered lexicographically, as with
+// Ordered[string](). Subtrees that cannot contain a match are skipped.
+// Average case time-complexity: O(depth + matches)
+// Worst case time-complexity: O(size)
+func (tree *Tree) TraversePrefix(prefix string, visitor Visitor) {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	tree.doTraversePrefix(tree.root, prefix, visitor)
+}
+
+func (tree *Tree) doTraversePrefix(node *_Node, prefix string, visitor Visitor) {
+	if node == nil {
+		return
+	}
+	// Everything in the left subtree is smaller than a value below the prefix
+	if tree.smaller(node.value, prefix) {
+		tree.doTraversePrefix(node.right(), prefix, visitor)
+		return
+	}
+	tree.doTraversePrefix(node.left, prefix, visitor)
+	// A value above the prefix range means the right subtree is out of range too
+	if !strings.HasPrefix(node.value.(string), prefix) {
+		return
+	}
+	visitor(node.value)
+	tree.doTraversePrefix(node.right(), prefix, visitor)
+}
@@ -0,0 +1,43 @@
+package bstree
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestTree_TraversePrefix(t *testing.T) {
+	tree := Ordered[string]()
+	words := []string{"", "a", "ab", "abc", "abd", "abz", "ac", "b", "ba", "bab", "c"}
+	for i := range words {
+		tree.Insert(words[(i*7)%len(words)])
+	}
+	for _, prefix := range []string{"", "a", "ab", "abc", "b", "bb", "c", "d"} {
+		var expected, actual []string
+		for _, word := range words {
+			if strings.HasPrefix(word, prefix) {
+				expected = append(expected, word)
+			}
+		}
+		tree.TraversePrefix(prefix, func(value interface{}) {
+			actual = append(actual, value.(string))
+		})
+		if fmt.Sprint(expected) != fmt.Sprint(actual) {
+			t.Errorf("TraversePrefix(%q): {Expected: %q | Actual: %q}", prefix, expected, actual)
+		}
+	}
+}
+
+// Find all values starting with a prefix
+func ExampleTree_TraversePrefix() {
+	tree := Ordered[string]()
+	for _, word := range []string{"tree", "trie", "treap", "heap", "trellis"} {
+		tree.Insert(word)
+	}
+	tree.TraversePrefix("tre", func(value interface{}) {
+		fmt.Printf("%s,", value)
+	})
+	fmt.Printf("\n")
+	// Output:
+	// treap,tree,trellis,
+}
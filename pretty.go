@@ -0,0 +1,50 @@
+package bstree
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PrintOptions control the output of PrettyString
+type PrintOptions struct {
+	Indent    string                         // indentation per level; defaults to four spaces
+	MaxDepth  int                            // number of levels to print; 0 prints all of them
+	Format    func(value interface{}) string // formats a value; defaults to fmt's %v
+	Addresses bool                           // prints the address of every node as well
+}
+
+// PrettyString renders the tree rotated by 90 degrees, one node per line
+// The root is at the left margin, right subtrees above and left subtrees
+// below their parent. Subtrees cut off by MaxDepth are shown as "...".
+// Time-complexity: O(size)
+func (tree *Tree) PrettyString(opts PrintOptions) string {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	if opts.Indent == "" {
+		opts.Indent = "    "
+	}
+	if opts.Format == nil {
+		opts.Format = tree.format
+	}
+	var builder strings.Builder
+	tree.doPrettyString(&builder, tree.root, 0, &opts)
+	return builder.String()
+}
+
+func (tree *Tree) doPrettyString(builder *strings.Builder, node *_Node, depth int, opts *PrintOptions) {
+	if node == nil {
+		return
+	}
+	indent := strings.Repeat(opts.Indent, depth)
+	if opts.MaxDepth > 0 && depth >= opts.MaxDepth {
+		builder.WriteString(indent + "...\n")
+		return
+	}
+	tree.doPrettyString(builder, node.right(), depth+1, opts)
+	builder.WriteString(indent + opts.Format(node.value))
+	if opts.Addresses {
+		fmt.Fprintf(builder, " (%p)", node)
+	}
+	builder.WriteString("\n")
+	tree.doPrettyString(builder, node.left, depth+1, opts)
+}
@@ -0,0 +1,41 @@
+package bstree
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestTree_PrettyStringOptions(t *testing.T) {
+	tree := CompleteTree(15)
+	if lines := strings.Count(tree.PrettyString(PrintOptions{}), "\n"); 15 != lines {
+		t.Errorf("Lines: {Expected: 15 | Actual: %d}", lines)
+	}
+	truncated := tree.PrettyString(PrintOptions{MaxDepth: 2})
+	if lines := strings.Count(truncated, "\n"); 3+4 != lines {
+		t.Errorf("Lines with MaxDepth 2: {Expected: 7 | Actual: %d}", lines)
+	}
+	if !strings.Contains(tree.PrettyString(PrintOptions{Addresses: true}), "(0x") {
+		t.Errorf("Addresses: {Expected: (0x... | Actual: none}")
+	}
+	if actual := EmptyTree().PrettyString(PrintOptions{}); actual != "" {
+		t.Errorf("Empty tree: {Expected: \"\" | Actual: %q}", actual)
+	}
+}
+
+// Render a tree sideways
+func ExampleTree_PrettyString() {
+	tree := CompleteTree(7)
+	fmt.Print(tree.PrettyString(PrintOptions{
+		Indent: "  ",
+		Format: func(value interface{}) string { return fmt.Sprintf("<%d>", value) },
+	}))
+	// Output:
+	//     <7>
+	//   <6>
+	//     <5>
+	// <4>
+	//     <3>
+	//   <2>
+	//     <1>
+}
@@ -0,0 +1,132 @@
+package bstree
+
+import (
+	"encoding/json"
+	"io"
+	"sync/atomic"
+)
+
+// _Counters counts the operations performed on a tree
+type _Counters struct {
+	inserts atomic.Int64
+	deletes atomic.Int64
+	lookups atomic.Int64
+	misses  atomic.Int64
+}
+
+// WithCounters makes the tree count calls to Insert, Delete and Exists for WriteProfile
+// The counters are updated atomically, which costs some scalability
+// when many goroutines read the tree in parallel.
+func WithCounters() Option {
+	return func(tree *Tree) {
+		tree.counters = new(_Counters)
+	}
+}
+
+func (counters *_Counters) countInsert() {
+	if counters != nil {
+		counters.inserts.Add(1)
+	}
+}
+
+func (counters *_Counters) countDelete() {
+	if counters != nil {
+		counters.deletes.Add(1)
+	}
+}
+
+func (counters *_Counters) countLookup() {
+	if counters != nil {
+		counters.lookups.Add(1)
+	}
+}
+
+func (counters *_Counters) countMiss() {
+	if counters != nil {
+		counters.misses.Add(1)
+	}
+}
+
+// Counters holds the number of calls counted WithCounters
+type Counters struct {
+	Inserts int64 `json:"inserts"` // calls to Insert
+	Deletes int64 `json:"deletes"` // calls to Delete
+	Lookups int64 `json:"lookups"` // calls to Exists
+	Misses  int64 `json:"misses"`  // calls to Exists that found nothing
+}
+
+// Counters returns the operation counts of Profile without walking the tree
+// It is the zero Counters unless the tree was created WithCounters. As it
+// takes no lock, metrics exporters can read it on every scrape, along with
+// Size and LockStats, and time operations WithSlowOpLogger.
+// Time-complexity: O(1)
+func (tree *Tree) Counters() Counters {
+	counters := tree.counters
+	if counters == nil {
+		return Counters{}
+	}
+	return Counters{
+		Inserts: counters.inserts.Load(),
+		Deletes: counters.deletes.Load(),
+		Lookups: counters.lookups.Load(),
+		Misses:  counters.misses.Load(),
+	}
+}
+
+// Profile describes the shape and usage of a tree for capacity planning
+type Profile struct {
+	Nodes      int   `json:"nodes"`
+	Leaves     int   `json:"leaves"`
+	Depth      int   `json:"depth"`
+	Width      int   `json:"width"`       // largest number of nodes on any level
+	Diameter   int   `json:"diameter"`    // number of edges on the longest path between two nodes
+	NodeBytes  int64 `json:"node_bytes"`  // estimated memory used by the nodes, excluding the values
+	ValueBytes int64 `json:"value_bytes"` // memory held by the values, if measured WithSizer
+	Levels     []int `json:"levels"`      // number of nodes at each depth, starting with the root at 0
+	Inserts    int64 `json:"inserts"`     // calls to Insert, if counted WithCounters
+	Deletes    int64 `json:"deletes"`     // calls to Delete, if counted WithCounters
+	Lookups    int64 `json:"lookups"`     // calls to Exists, if counted WithCounters
+	Misses     int64 `json:"misses"`      // calls to Exists that found nothing, if counted WithCounters
+}
+
+// Profile returns statistics about the shape and usage of the tree
+// Time-complexity: O(size)
+func (tree *Tree) Profile() Profile {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	return tree.profile()
+}
+
+// profile implements Profile on a locked tree
+func (tree *Tree) profile() Profile {
+	profile := Profile{
+		Nodes:      tree.size,
+		NodeBytes:  tree.nodeBytes(),
+		ValueBytes: tree.valueBytes(),
+		Levels:     []int{},
+	}
+	tree.doTraverseNodes(tree.root, 0, PreOrder, func(value interface{}, depth int, isLeaf bool) {
+		if depth == len(profile.Levels) {
+			profile.Levels = append(profile.Levels, 0)
+		}
+		profile.Levels[depth]++
+		if isLeaf {
+			profile.Leaves++
+		}
+	})
+	profile.Depth = len(profile.Levels)
+	for _, nodes := range profile.Levels {
+		profile.Width = max(profile.Width, nodes)
+	}
+	profile.Diameter = tree.diameter()
+	counters := tree.Counters()
+	profile.Inserts, profile.Deletes = counters.Inserts, counters.Deletes
+	profile.Lookups, profile.Misses = counters.Lookups, counters.Misses
+	return profile
+}
+
+// WriteProfile writes the Profile of the tree to w as a single line of JSON
+// Time-complexity: O(size)
+func (tree *Tree) WriteProfile(w io.Writer) error {
+	return json.NewEncoder(w).Encode(tree.Profile())
+}
@@ -0,0 +1,58 @@
+package bstree
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestTree_Profile(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithCounters())
+	for _, value := range Values(CompleteTree(10)) {
+		tree.Insert(value)
+	}
+	tree.Insert(1)
+	tree.Exists(3)
+	tree.Delete(11)
+	var buffer bytes.Buffer
+	if err := tree.WriteProfile(&buffer); err != nil {
+		t.Fatalf("WriteProfile: {Expected: <nil> | Actual: %v}", err)
+	}
+	var profile Profile
+	if err := json.Unmarshal(buffer.Bytes(), &profile); err != nil {
+		t.Fatalf("Unmarshal: {Expected: <nil> | Actual: %v}", err)
+	}
+	// Sorted inserts degenerate into a chain
+	expected := Profile{Nodes: 10, Leaves: 1, Depth: 10, Width: 1, Diameter: 9, NodeBytes: profile.NodeBytes,
+		Levels: []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, Inserts: 11, Deletes: 1, Lookups: 1}
+	if !reflect.DeepEqual(expected, profile) {
+		t.Errorf("Profile: {Expected: %+v | Actual: %+v}", expected, profile)
+	}
+	if profile.NodeBytes <= 0 {
+		t.Errorf("NodeBytes: {Expected: > 0 | Actual: %d}", profile.NodeBytes)
+	}
+	if expected, actual := (Counters{Inserts: 11, Deletes: 1, Lookups: 1}), tree.Counters(); expected != actual {
+		t.Errorf("Counters: {Expected: %+v | Actual: %+v}", expected, actual)
+	}
+}
+
+// Dump statistics of a tree
+func ExampleTree_Profile() {
+	tree := CompleteTree(7)
+	profile := tree.Profile()
+	fmt.Println(profile.Nodes, profile.Leaves, profile.Levels)
+	// Output:
+	// 7 4 [1 2 4]
+}
+
+// Read the operation counts for a metrics exporter
+func ExampleTree_Counters() {
+	tree := New(IntSmaller, IntLarger, WithCounters())
+	tree.Insert(1)
+	tree.Exists(2)
+	fmt.Printf("%+v\n", tree.Counters())
+	// Output:
+	// {Inserts:1 Deletes:0 Lookups:1 Misses:1}
+}
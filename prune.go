@@ -0,0 +1,11 @@
+package bstree
+
+// PruneOlderThan removes all values smaller than cutoff and returns the number of removed values
+// For trees ordered by time this drops everything older than cutoff,
+// which makes it a one-call retention policy for time series.
+// It is a shorthand for DeleteRange(Range{LT: cutoff}).
+// Average case time-complexity: O(min(depth * removed values, size))
+// Worst case time-complexity: O(size)
+func (tree *Tree) PruneOlderThan(cutoff interface{}) int {
+	return tree.DeleteRange(Range{LT: cutoff})
+}
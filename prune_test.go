@@ -0,0 +1,39 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestTree_PruneOlderThan(t *testing.T) {
+	tree := CompleteTree(100)
+	if removed := tree.PruneOlderThan(41); removed != 40 {
+		t.Errorf("PruneOlderThan(41): {Expected: %d | Actual: %d}", 40, removed)
+	}
+	if tree.Minimum() != 41 {
+		t.Errorf("Minimum: {Expected: %d | Actual: %v}", 41, tree.Minimum())
+	}
+	if removed := tree.PruneOlderThan(0); removed != 0 {
+		t.Errorf("PruneOlderThan(0): {Expected: %d | Actual: %d}", 0, removed)
+	}
+	mustCheck(t, tree)
+}
+
+// Keep only the events of the last hour
+func ExampleTree_PruneOlderThan() {
+	before := func(value interface{}, other interface{}) bool {
+		return value.(time.Time).Before(other.(time.Time))
+	}
+	after := func(value interface{}, other interface{}) bool {
+		return value.(time.Time).After(other.(time.Time))
+	}
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tree := New(before, after)
+	for minutes := 0; minutes < 180; minutes += 30 {
+		tree.Insert(now.Add(-time.Duration(minutes) * time.Minute))
+	}
+	fmt.Println(tree.PruneOlderThan(now.Add(-time.Hour)), tree.Size())
+	// Output:
+	// 3 3
+}
@@ -0,0 +1,78 @@
+package bstree
+
+import "math"
+
+// Bucket describes a range of values in a histogram
+// Min and Max are the smallest and largest value in the bucket.
+type Bucket struct {
+	Min   interface{}
+	Max   interface{}
+	Count int
+}
+
+// selectNode returns the node holding the k-th smallest value (0-indexed)
+func (tree *Tree) selectNode(k int) *_Node {
+	tree.assertLocked()
+	node := tree.root
+	for node != nil {
+		left := sizeOf(node.left)
+		switch {
+		case k < left:
+			node = node.left
+		case k > left:
+			k -= left + 1
+			node = node.right()
+		default:
+			return node
+		}
+	}
+	return nil
+}
+
+// Quantile returns the value below or at which a fraction q of the values lie
+// The nearest-rank method is used, so the result is always a value in the tree.
+// q is clamped to [0, 1]. Returns nil if the tree is empty.
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (tree *Tree) Quantile(q float64) interface{} {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	if tree.size == 0 {
+		return nil
+	}
+	k := int(math.Ceil(q*float64(tree.size))) - 1
+	switch {
+	case k < 0:
+		k = 0
+	case k >= tree.size:
+		k = tree.size - 1
+	}
+	return tree.selectNode(k).value
+}
+
+// Histogram splits the values of the tree into at most buckets ranges of equal count
+// When the size is not a multiple of buckets, counts differ by at most one.
+// A buckets count that isn't positive returns nil.
+// Average case time-complexity: O(buckets * depth)
+// Worst case time-complexity: O(buckets * size)
+func (tree *Tree) Histogram(buckets int) []Bucket {
+	if buckets <= 0 {
+		return nil
+	}
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	if buckets > tree.size {
+		buckets = tree.size
+	}
+	histogram := make([]Bucket, 0, buckets)
+	for i := 0; i < buckets; i++ {
+		begin := i * tree.size / buckets
+		end := (i + 1) * tree.size / buckets
+		histogram = append(histogram, Bucket{
+			Min:   tree.selectNode(begin).value,
+			Max:   tree.selectNode(end - 1).value,
+			Count: end - begin,
+		})
+	}
+	return histogram
+}
@@ -0,0 +1,58 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_Quantile(t *testing.T) {
+	tree := RandomTree(100, 100)
+	cases := []struct {
+		q        float64
+		expected int
+	}{
+		{-1, 0}, {0, 0}, {0.01, 0}, {0.5, 49}, {0.95, 94}, {0.99, 98}, {1, 99}, {2, 99},
+	}
+	for _, c := range cases {
+		if actual := tree.Quantile(c.q); c.expected != actual {
+			t.Errorf("Quantile(%v): {Expected: %d | Actual: %v}", c.q, c.expected, actual)
+		}
+	}
+	if actual := EmptyTree().Quantile(0.5); actual != nil {
+		t.Errorf("Quantile(0.5) on empty tree: {Expected: <nil> | Actual: %v}", actual)
+	}
+}
+
+func TestTree_QuantileAfterDelete(t *testing.T) {
+	tree := CompleteTree(100)
+	for i := 1; i <= 50; i++ {
+		tree.Delete(i)
+	}
+	if actual := tree.Quantile(0); 51 != actual {
+		t.Errorf("Quantile(0): {Expected: 51 | Actual: %v}", actual)
+	}
+	if actual := tree.Quantile(0.5); 75 != actual {
+		t.Errorf("Quantile(0.5): {Expected: 75 | Actual: %v}", actual)
+	}
+}
+
+func TestTree_HistogramInvalidBuckets(t *testing.T) {
+	tree := CompleteTree(10)
+	for _, buckets := range []int{0, -1} {
+		if actual := tree.Histogram(buckets); actual != nil {
+			t.Errorf("Histogram(%d): {Expected: [] | Actual: %v}", buckets, actual)
+		}
+	}
+}
+
+// Split a tree into buckets of equal count
+func ExampleTree_Histogram() {
+	tree := CompleteTree(10)
+	for _, bucket := range tree.Histogram(3) {
+		fmt.Println(bucket.Min, bucket.Max, bucket.Count)
+	}
+	// Output:
+	// 1 3 3
+	// 4 6 3
+	// 7 10 4
+}
@@ -0,0 +1,150 @@
+package bstree
+
+import "math/bits"
+
+// Range describes an interval of values
+// Set at most one lower bound (GT or GTE) and at most one upper bound
+// (LT or LTE); nil bounds are unbounded. If both variants of a bound
+// are set, the exclusive one is used. The zero Range covers all values.
+type Range struct {
+	GT  interface{} // values must be larger than GT
+	GTE interface{} // values must be larger than or equal to GTE
+	LT  interface{} // values must be smaller than LT
+	LTE interface{} // values must be smaller than or equal to LTE
+}
+
+// tooSmall checks if value lies below the lower bound of r
+func (tree *Tree) tooSmall(r Range, value interface{}) bool {
+	switch {
+	case r.GT != nil:
+		return !tree.larger(value, r.GT)
+	case r.GTE != nil:
+		return tree.smaller(value, r.GTE)
+	}
+	return false
+}
+
+// tooLarge checks if value lies above the upper bound of r
+func (tree *Tree) tooLarge(r Range, value interface{}) bool {
+	switch {
+	case r.LT != nil:
+		return !tree.smaller(value, r.LT)
+	case r.LTE != nil:
+		return tree.larger(value, r.LTE)
+	}
+	return false
+}
+
+// TraverseRange calls visitor in sorted order on each value within r
+// Average case time-complexity: O(depth + values in range)
+// Worst case time-complexity: O(size)
+func (tree *Tree) TraverseRange(r Range, visitor Visitor) {
+	defer tree.recoverPanic()
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	tree.doAscend(tree.root, r, func(value interface{}) bool {
+		visitor(value)
+		return true
+	})
+}
+
+// doAscend visits the values within r in order until iterator returns false
+// Returns false if the iteration was stopped.
+func (tree *Tree) doAscend(node *_Node, r Range, iterator ItemIterator) bool {
+	if node == nil {
+		return true
+	}
+	if tree.tooSmall(r, node.value) {
+		return tree.doAscend(node.right(), r, iterator)
+	}
+	if tree.tooLarge(r, node.value) {
+		return tree.doAscend(node.left, r, iterator)
+	}
+	return tree.doAscend(node.left, r, iterator) &&
+		iterator(node.value) &&
+		tree.doAscend(node.right(), r, iterator)
+}
+
+// CountRange returns the number of values within r
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (tree *Tree) CountRange(r Range) int {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	return tree.countRange(r)
+}
+
+func (tree *Tree) countRange(r Range) int {
+	upper := tree.size
+	if r.LT != nil || r.LTE != nil {
+		upper = tree.countBelow(func(value interface{}) bool {
+			return !tree.tooLarge(r, value)
+		})
+	}
+	lower := 0
+	if r.GT != nil || r.GTE != nil {
+		lower = tree.countBelow(func(value interface{}) bool {
+			return tree.tooSmall(r, value)
+		})
+	}
+	if upper < lower {
+		return 0
+	}
+	return upper - lower
+}
+
+// countBelow returns the number of values for which below holds
+// below has to hold for a prefix of the values in sorted order.
+func (tree *Tree) countBelow(below func(value interface{}) bool) int {
+	count := 0
+	node := tree.root
+	for node != nil {
+		if below(node.value) {
+			count += sizeOf(node.left) + 1
+			node = node.right()
+		} else {
+			node = node.left
+		}
+	}
+	return count
+}
+
+// DeleteRange removes all values within r and returns the number of removed values
+// Few values are deleted one by one; if a large part of the tree is
+// affected, the remaining values are rebuilt into a balanced tree instead.
+// Hooks and the write-ahead log see a delete of every removed value.
+// Average case time-complexity: O(min(depth * values in range, size))
+// Worst case time-complexity: O(size)
+func (tree *Tree) DeleteRange(r Range) int {
+	if tree.lockOp("DeleteRange") != nil {
+		return 0
+	}
+	defer tree.mutex.Unlock()
+	var doomed []interface{}
+	tree.doAscend(tree.root, r, func(value interface{}) bool {
+		doomed = append(doomed, value)
+		return true
+	})
+	if len(doomed) == 0 {
+		return 0
+	}
+	if len(doomed)*bits.Len(uint(tree.size)) < tree.size {
+		for _, value := range doomed {
+			tree.delete(value)
+		}
+	} else {
+		kept := make([]interface{}, 0, tree.size-len(doomed))
+		tree.doInOrder(tree.root, func(value interface{}) {
+			if tree.tooSmall(r, value) || tree.tooLarge(r, value) {
+				kept = append(kept, value)
+			}
+		})
+		tree.freeSubtree(tree.root)
+		tree.load(kept)
+	}
+	for _, value := range doomed {
+		tree.wal.log(_WALDelete, value)
+		tree.hooks.fireDelete(value)
+	}
+	return len(doomed)
+}
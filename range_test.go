@@ -0,0 +1,91 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_Range(t *testing.T) {
+	tree := EmptyTree()
+	for i := 0; i < 100; i += 3 {
+		tree.Insert((i * 37) % 102)
+	}
+	var values []int
+	tree.Traverse(InOrder, func(value interface{}) {
+		values = append(values, value.(int))
+	})
+	for lo := -2; lo < 104; lo += 5 {
+		for hi := lo - 2; hi < 104; hi += 7 {
+			ranges := map[string]Range{
+				"GT,LT":   {GT: lo, LT: hi},
+				"GT,LTE":  {GT: lo, LTE: hi},
+				"GTE,LT":  {GTE: lo, LT: hi},
+				"GTE,LTE": {GTE: lo, LTE: hi},
+				"GTE":     {GTE: lo},
+				"LT":      {LT: hi},
+			}
+			contains := map[string]func(v int) bool{
+				"GT,LT":   func(v int) bool { return v > lo && v < hi },
+				"GT,LTE":  func(v int) bool { return v > lo && v <= hi },
+				"GTE,LT":  func(v int) bool { return v >= lo && v < hi },
+				"GTE,LTE": func(v int) bool { return v >= lo && v <= hi },
+				"GTE":     func(v int) bool { return v >= lo },
+				"LT":      func(v int) bool { return v < hi },
+			}
+			for name, r := range ranges {
+				var expected, actual []int
+				for _, v := range values {
+					if contains[name](v) {
+						expected = append(expected, v)
+					}
+				}
+				tree.TraverseRange(r, func(value interface{}) {
+					actual = append(actual, value.(int))
+				})
+				if fmt.Sprint(expected) != fmt.Sprint(actual) {
+					t.Errorf("TraverseRange(%s %d %d): {Expected: %v | Actual: %v}", name, lo, hi, expected, actual)
+				}
+				if count := tree.CountRange(r); len(expected) != count {
+					t.Errorf("CountRange(%s %d %d): {Expected: %d | Actual: %d}", name, lo, hi, len(expected), count)
+				}
+			}
+		}
+	}
+	if expected := tree.Size(); expected != tree.CountRange(Range{}) {
+		t.Errorf("CountRange(all): {Expected: %d | Actual: %d}", expected, tree.CountRange(Range{}))
+	}
+}
+
+func TestTree_DeleteRange(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		for _, r := range []Range{{GTE: 10, LT: 20}, {GT: 5}, {LTE: 90}, {}} {
+			tree := New(IntSmaller, IntLarger, backend)
+			for _, value := range Values(RandomTree(80, 100)) {
+				tree.Insert(value)
+			}
+			expected := tree.Size() - tree.CountRange(r)
+			deleted := 0
+			tree.OnDelete(func(value interface{}) { deleted++ })
+			if removed := tree.DeleteRange(r); removed != deleted || tree.Size() != expected {
+				t.Errorf("DeleteRange(%+v): {Expected: %d %d | Actual: %d %d}", r, deleted, expected, removed, tree.Size())
+			}
+			if count := tree.CountRange(r); count != 0 {
+				t.Errorf("CountRange(%+v): {Expected: 0 | Actual: %d}", r, count)
+			}
+			mustCheck(t, tree)
+		}
+	})
+}
+
+// Visit a half-open interval
+func ExampleTree_TraverseRange() {
+	tree := CompleteTree(10)
+	tree.TraverseRange(Range{GTE: 3, LT: 7}, func(value interface{}) {
+		fmt.Printf("%d,", value)
+	})
+	fmt.Printf("\n")
+	fmt.Println(tree.CountRange(Range{GT: 3, LTE: 7}))
+	// Output:
+	// 3,4,5,6,
+	// 4
+}
@@ -0,0 +1,52 @@
+package bstree
+
+import "math"
+
+// WithAutoRebalance rebuilds an Unbalanced tree whenever an insertion
+// creates a node deeper than threshold * log2(size)
+// The rebuild happens inline in the Insert that crossed the threshold and
+// takes O(size), so a threshold close to 1 rebuilds very often; values of
+// 2 or 3 keep lookups logarithmic while rebuilding rarely on random input.
+// A threshold of 0 or below disables the option, thresholds between 0 and
+// 1 are treated as 1. Trees using a balancing algorithm other than
+// Unbalanced never need it and ignore the option.
+func WithAutoRebalance(threshold float64) Option {
+	return func(tree *Tree) {
+		tree.autoRebalance = 0
+		if threshold > 0 {
+			tree.autoRebalance = math.Max(threshold, 1)
+		}
+	}
+}
+
+// unbalanced reports whether a node at depth exceeds the auto-rebalance threshold
+func (tree *Tree) unbalanced(depth int) bool {
+	if depth == 0 || tree.autoRebalance == 0 {
+		return false
+	}
+	return float64(depth) > tree.autoRebalance*float64(minimalDepth(tree.size))
+}
+
+// minimalDepth returns the depth of a perfectly balanced tree of size values
+func minimalDepth(size int) int {
+	return int(math.Ceil(math.Log2(float64(size + 1))))
+}
+
+// rebalance rebuilds the tree with minimal depth
+// Time-complexity: O(size)
+func (tree *Tree) rebalance() {
+	tree.assertWriteLocked()
+	values := tree.values()
+	tree.freeSubtree(tree.root)
+	tree.load(values)
+}
+
+// freeSubtree hands every node of the subtree back to the allocator
+func (tree *Tree) freeSubtree(node *_Node) {
+	if node == nil || tree.allocator == nil {
+		return
+	}
+	tree.freeSubtree(node.left)
+	tree.freeSubtree(node.right())
+	tree.freeNode(node)
+}
@@ -0,0 +1,77 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_AutoRebalance(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithAutoRebalance(2), WithAllocator(NewSlabAllocator(64)))
+	for i := 0; i < 1000; i++ {
+		tree.Insert(i)
+	}
+	// The optimal depth of 1000 values is 10
+	if depth := tree.Depth(); depth > 20 {
+		t.Errorf("Depth: {Expected: <= %d | Actual: %d}", 20, depth)
+	}
+	if expected := 1000; expected != tree.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+	}
+	mustCheck(t, tree)
+}
+
+func TestTree_AutoRebalanceRandom(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithAutoRebalance(3))
+	random := RandomTree(1000, 1000000)
+	for _, value := range Values(random) {
+		tree.Insert(value)
+	}
+	if tree.Size() != random.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", random.Size(), tree.Size())
+	}
+	mustCheck(t, tree)
+}
+
+func TestTree_AutoRebalanceDisabled(t *testing.T) {
+	for _, threshold := range []float64{0, -1} {
+		tree := New(IntSmaller, IntLarger, WithAutoRebalance(threshold))
+		for i := 0; i < 100; i++ {
+			tree.Insert(i)
+		}
+		if expected := 100; expected != tree.Depth() {
+			t.Errorf("Depth with threshold %v: {Expected: %d | Actual: %d}", threshold, expected, tree.Depth())
+		}
+	}
+}
+
+func TestTree_AutoRebalanceCalls(t *testing.T) {
+	if debug {
+		t.Skip("debug builds compare while checking each modification")
+	}
+	calls := 0
+	tree := NewCompare(func(value interface{}, other interface{}) int {
+		calls++
+		return value.(int) - other.(int)
+	}, WithAutoRebalance(2))
+	// Level order keeps the tree complete, so nothing triggers a rebuild
+	CompleteTree(127).Traverse(LevelOrder, func(value interface{}) {
+		tree.Insert(value)
+	})
+	// The depth comes from the insert itself, without a second descent
+	calls = 0
+	tree.Insert(0)
+	if expected := 7; expected != calls {
+		t.Errorf("Calls of Insert(0): {Expected: %d | Actual: %d}", expected, calls)
+	}
+}
+
+// Keep a tree fed with sorted input shallow
+func ExampleWithAutoRebalance() {
+	tree := New(IntSmaller, IntLarger, WithAutoRebalance(1.5))
+	for i := 1; i <= 100; i++ {
+		tree.Insert(i)
+	}
+	fmt.Println(tree.Depth() <= 11)
+	// Output:
+	// true
+}
@@ -0,0 +1,34 @@
+package bstree
+
+import "sort"
+
+// Rebuilt returns a balanced copy of the tree ordered by smaller and larger instead
+// It is meant for sort criteria that change at runtime, e.g. when a user
+// switches the sort column. The copy is configured like the tree, see
+// Filter, except for settings tied to the old ordering: lookups by key and
+// pre-keys from WithKeyCache and the bloom filter don't carry over, and
+// the nil policy wraps the new comparators. Values that are equal under the new ordering are
+// merged, keeping the first in the old order, unless the tree has duplicates.
+// Time-complexity: O(size * log(size))
+func (tree *Tree) Rebuilt(smaller Smaller, larger Larger) *Tree {
+	tree.rlock()
+	values := tree.values()
+	tree.mutex.RUnlock()
+	rebuilt := tree.sibling()
+	rebuilt.smaller, rebuilt.larger = smaller, larger
+	rebuilt.compare = deriveCompare(smaller, larger)
+	rebuilt.key, rebuilt.keySmaller, rebuilt.keyLarger = nil, nil, nil
+	rebuilt.keyCache = nil
+	rebuilt.bloom = nil
+	WithNilPolicy(tree.nilPolicy)(rebuilt)
+	sort.SliceStable(values, func(a, b int) bool {
+		return rebuilt.smaller(values[a], values[b])
+	})
+	if !rebuilt.duplicates {
+		values = dedupSorted(rebuilt.larger, values)
+	}
+	rebuilt.mutex.Lock()
+	defer rebuilt.mutex.Unlock()
+	rebuilt.load(values)
+	return rebuilt
+}
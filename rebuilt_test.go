@@ -0,0 +1,36 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_Rebuilt(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := NewByKey(userID, IntSmaller, IntLarger, backend)
+		names := []string{"dave", "alice", "carol", "bob", "alice"}
+		for i, name := range names {
+			tree.Insert(user{id: i, name: name})
+		}
+		byName := func(a interface{}, b interface{}) bool { return a.(user).name < b.(user).name }
+		rebuilt := tree.Rebuilt(byName, func(a interface{}, b interface{}) bool { return byName(b, a) })
+		mustCheck(t, rebuilt)
+		if expected := "[{1 alice} {3 bob} {2 carol} {0 dave}]"; expected != fmt.Sprint(Values(rebuilt)) {
+			t.Errorf("Values: {Expected: %s | Actual: %v}", expected, Values(rebuilt))
+		}
+		if expected := 5; expected != tree.Size() {
+			t.Errorf("Size of the original: {Expected: %d | Actual: %d}", expected, tree.Size())
+		}
+		if !rebuilt.Exists(user{name: "carol"}) {
+			t.Errorf("Exists(carol): {Expected: true | Actual: false}")
+		}
+	})
+}
+
+// Re-sort a tree in descending order
+func ExampleTree_Rebuilt() {
+	tree := CompleteTree(5)
+	fmt.Println(tree.Rebuilt(IntLarger, IntSmaller))
+	// Output:
+	// {size: 5 | depth: 3 | min: 5 | max: 1 | values: [5 4 3 2 1]}
+}
@@ -0,0 +1,47 @@
+package bstree
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrPanicked wraps panics recovered from user code in trees created WithPanicRecovery
+var ErrPanicked = errors.New("bstree: recovered panic")
+
+// WithPanicRecovery keeps panics in comparators, visitors and hooks from crashing the program
+// Insert, Exists, Delete, GetOrInsert, InsertIf, View, Fold, Traverse,
+// TraverseRange and TraverseNodes recover such panics, report them to
+// handler and return their zero values. InsertE, ExistsE and DeleteE
+// return them as errors instead. Errors of comparators created by NewE
+// are reported as they are, all other panics wrap ErrPanicked. handler may
+// be nil. The tree stays consistent: comparisons happen before any
+// modification, except for the splaying that follows an Insert or Delete on
+// Splay trees, after which the change is kept. A visitor that panicked
+// stopped the traversal early.
+func WithPanicRecovery(handler func(err error)) Option {
+	return func(tree *Tree) {
+		if handler == nil {
+			handler = func(err error) {}
+		}
+		tree.recovery = handler
+	}
+}
+
+// recoverPanic reports a panic to the handler of a tree created WithPanicRecovery
+// Other trees let the panic continue. It has to be deferred directly.
+func (tree *Tree) recoverPanic() {
+	if tree.recovery == nil {
+		return
+	}
+	if r := recover(); r != nil {
+		tree.recovery(panicError(r))
+	}
+}
+
+// panicError converts a recovered value into an error
+func panicError(r interface{}) error {
+	if ce, ok := r.(_CompareError); ok {
+		return ce.err
+	}
+	return fmt.Errorf("%w: %v", ErrPanicked, r)
+}
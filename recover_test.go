@@ -0,0 +1,106 @@
+package bstree
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestTree_PanicRecovery(t *testing.T) {
+	var errs []error
+	tree := New(IntSmaller, IntLarger, WithPanicRecovery(func(err error) {
+		errs = append(errs, err)
+	}))
+	for i := 0; i < 10; i++ {
+		tree.Insert(i)
+	}
+	if tree.Insert("ten") || tree.Exists("ten") || tree.Delete("ten") {
+		t.Errorf("Insert/Exists/Delete(ten): {Expected: false | Actual: true}")
+	}
+	tree.Traverse(InOrder, func(value interface{}) {
+		panic("visitor")
+	})
+	if expected := 4; expected != len(errs) {
+		t.Fatalf("Errors: {Expected: %d | Actual: %d}", expected, len(errs))
+	}
+	for _, err := range errs {
+		if !errors.Is(err, ErrPanicked) {
+			t.Errorf("Error: {Expected: %v | Actual: %v}", ErrPanicked, err)
+		}
+	}
+	if err := tree.InsertE("ten"); !errors.Is(err, ErrPanicked) {
+		t.Errorf("InsertE(ten): {Expected: %v | Actual: %v}", ErrPanicked, err)
+	}
+	// The lock was released and the tree is intact
+	if expected := 10; expected != tree.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+	}
+	mustCheck(t, tree)
+}
+
+func TestTree_DeletePanicLeavesTreeIntact(t *testing.T) {
+	// The comparators panic on their limit-th call, so every comparison
+	// Delete makes gets its turn to fail
+	calls, limit := 0, 0
+	counting := func(compare func(value interface{}, other interface{}) bool) func(value interface{}, other interface{}) bool {
+		return func(value interface{}, other interface{}) bool {
+			if calls++; calls == limit {
+				panic("comparator")
+			}
+			return compare(value, other)
+		}
+	}
+	smaller, larger := counting(IntSmaller), counting(IntLarger)
+	for _, balancing := range []Balancing{Unbalanced, LLRB} {
+		for limit = 1; ; limit++ {
+			saved := limit
+			limit = 0
+			tree := New(smaller, larger, WithBalancing(balancing), WithPanicRecovery(nil))
+			for _, value := range rand.Perm(64) {
+				tree.Insert(value)
+			}
+			calls, limit = 0, saved
+			tree.Delete(0)
+			limit = 0
+			mustCheck(t, tree)
+			// Debug builds compare while checking the result, so the
+			// panic may come after the delete finished
+			expected := 63
+			if tree.Exists(0) {
+				expected = 64
+			}
+			if expected != tree.Size() {
+				t.Fatalf("Size after panic on comparison %d: {Expected: %d | Actual: %d}", saved, expected, tree.Size())
+			}
+			if calls < saved {
+				break
+			}
+			limit = saved
+		}
+	}
+}
+
+func TestTree_PanicWithoutRecovery(t *testing.T) {
+	tree := CompleteTree(3)
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Panic: {Expected: true | Actual: false}")
+		}
+	}()
+	tree.Insert("ten")
+}
+
+// Survive a value of the wrong type in a shared tree
+func ExampleWithPanicRecovery() {
+	tree := New(IntSmaller, IntLarger, WithPanicRecovery(func(err error) {
+		fmt.Println("recovered:", errors.Is(err, ErrPanicked))
+	}))
+	tree.Insert(1)
+	fmt.Println(tree.Insert("two"))
+	fmt.Println(tree.Size())
+	// Output:
+	// recovered: true
+	// false
+	// 1
+}
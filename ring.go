@@ -0,0 +1,36 @@
+package bstree
+
+// _Ring is a FIFO queue in a circular slice
+// It only allocates when it grows, so a level-order walk allocates
+// O(log(width)) times instead of once per node as with container/list.
+type _Ring[T any] struct {
+	items []T
+	head  int // index of the oldest item
+	count int
+}
+
+func (ring *_Ring[T]) len() int {
+	return ring.count
+}
+
+// push appends item, doubling the capacity if the ring is full
+func (ring *_Ring[T]) push(item T) {
+	if ring.count == len(ring.items) {
+		grown := make([]T, 2*len(ring.items)+8)
+		n := copy(grown, ring.items[ring.head:])
+		copy(grown[n:], ring.items[:ring.head])
+		ring.items, ring.head = grown, 0
+	}
+	ring.items[(ring.head+ring.count)%len(ring.items)] = item
+	ring.count++
+}
+
+// pop removes and returns the oldest item; the ring must not be empty
+func (ring *_Ring[T]) pop() T {
+	var zero T
+	item := ring.items[ring.head]
+	ring.items[ring.head] = zero
+	ring.head = (ring.head + 1) % len(ring.items)
+	ring.count--
+	return item
+}
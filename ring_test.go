@@ -0,0 +1,39 @@
+package bstree
+
+import "testing"
+
+func TestRing(t *testing.T) {
+	var ring _Ring[int]
+	next, expected := 0, 0
+	// Interleave pushes and pops so the ring wraps around while growing
+	for round := 0; round < 100; round++ {
+		for i := 0; i < 3; i++ {
+			ring.push(next)
+			next++
+		}
+		for i := 0; i < 2; i++ {
+			if actual := ring.pop(); expected != actual {
+				t.Fatalf("pop: {Expected: %d | Actual: %d}", expected, actual)
+			}
+			expected++
+		}
+	}
+	if expected := 100; expected != ring.len() {
+		t.Errorf("len: {Expected: %d | Actual: %d}", expected, ring.len())
+	}
+	for ring.len() > 0 {
+		if actual := ring.pop(); expected != actual {
+			t.Fatalf("pop: {Expected: %d | Actual: %d}", expected, actual)
+		}
+		expected++
+	}
+}
+
+func TestTree_LevelOrderAllocations(t *testing.T) {
+	tree := CompleteTree(1 << 12)
+	visitor := func(value interface{}) {}
+	// The queue doubles up to the width of the widest level
+	if allocs := testing.AllocsPerRun(10, func() { tree.Traverse(LevelOrder, visitor) }); allocs > 12 {
+		t.Errorf("Allocations per traversal: {Expected: <= 12 | Actual: %v}", allocs)
+	}
+}
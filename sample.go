@@ -0,0 +1,56 @@
+package bstree
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// intn draws from rng, or from the default source if rng is nil
+func intn(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.Intn(n)
+	}
+	return rng.Intn(n)
+}
+
+// RandomValue returns a uniformly random value of the tree
+// Returns nil if the tree is empty. A nil rng uses the default source.
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (tree *Tree) RandomValue(rng *rand.Rand) interface{} {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	if tree.size == 0 {
+		return nil
+	}
+	return tree.selectNode(intn(rng, tree.size)).value
+}
+
+// Sample returns k distinct values of the tree chosen uniformly at random
+// The values are returned in sorted order. If k exceeds the size of the
+// tree, all values are returned; if k isn't positive, none are. A nil rng
+// uses the default source.
+// Average case time-complexity: O(k * depth)
+// Worst case time-complexity: O(k * size)
+func (tree *Tree) Sample(rng *rand.Rand, k int) []interface{} {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	k = max(min(k, tree.size), 0)
+	// Floyd's algorithm draws k distinct ranks with k random numbers
+	chosen := make(map[int]bool, k)
+	ranks := make([]int, 0, k)
+	for j := tree.size - k; j < tree.size; j++ {
+		rank := intn(rng, j+1)
+		if chosen[rank] {
+			rank = j
+		}
+		chosen[rank] = true
+		ranks = append(ranks, rank)
+	}
+	sort.Ints(ranks)
+	sample := make([]interface{}, 0, k)
+	for _, rank := range ranks {
+		sample = append(sample, tree.selectNode(rank).value)
+	}
+	return sample
+}
@@ -0,0 +1,43 @@
+package bstree
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestTree_RandomValue(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	tree := CompleteTree(10)
+	counts := make(map[interface{}]int)
+	for i := 0; i < 10000; i++ {
+		counts[tree.RandomValue(rng)]++
+	}
+	for i := 1; i <= 10; i++ {
+		if counts[i] < 800 || counts[i] > 1200 {
+			t.Errorf("Count of %d: {Expected: ~1000 | Actual: %d}", i, counts[i])
+		}
+	}
+	if actual := EmptyTree().RandomValue(rng); actual != nil {
+		t.Errorf("RandomValue on empty tree: {Expected: <nil> | Actual: %v}", actual)
+	}
+}
+
+func TestTree_Sample(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	tree := RandomTree(100, 1000)
+	for _, k := range []int{-1, 0, 1, 10, 100, 200} {
+		sample := tree.Sample(rng, k)
+		expected := max(min(k, tree.Size()), 0)
+		if expected != len(sample) {
+			t.Errorf("Sample(%d) length: {Expected: %d | Actual: %d}", k, expected, len(sample))
+		}
+		for i, value := range sample {
+			if !tree.Exists(value) {
+				t.Errorf("Sample(%d) Exists(%v): {Expected: true | Actual: false}", k, value)
+			}
+			if i > 0 && !IntSmaller(sample[i-1], value) {
+				t.Errorf("Sample(%d) order: {Expected: %v < %v}", k, sample[i-1], value)
+			}
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package bstree
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// _Semver is a parsed semantic version, see https://semver.org
+type _Semver struct {
+	core       [3]uint64
+	prerelease []string
+}
+
+// parseSemver parses versions like 1.2.3, v1.2.3-rc.1 or 1.2.3+build.5
+// Build metadata is ignored, as it does not take part in the precedence.
+func parseSemver(s string) (_Semver, bool) {
+	var version _Semver
+	s = strings.TrimPrefix(s, "v")
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		s = s[:i]
+	}
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		version.prerelease = strings.Split(s[i+1:], ".")
+		s = s[:i]
+		for _, identifier := range version.prerelease {
+			if identifier == "" {
+				return version, false
+			}
+		}
+	}
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return version, false
+	}
+	for i, part := range parts {
+		number, err := strconv.ParseUint(part, 10, 64)
+		if err != nil || (len(part) > 1 && part[0] == '0') {
+			return version, false
+		}
+		version.core[i] = number
+	}
+	return version, true
+}
+
+// compareSemver compares two versions by semver precedence
+func compareSemver(a _Semver, b _Semver) int {
+	for i := range a.core {
+		switch {
+		case a.core[i] < b.core[i]:
+			return -1
+		case a.core[i] > b.core[i]:
+			return 1
+		}
+	}
+	// A pre-release has lower precedence than the release itself
+	switch {
+	case len(a.prerelease) == 0 && len(b.prerelease) == 0:
+		return 0
+	case len(a.prerelease) == 0:
+		return 1
+	case len(b.prerelease) == 0:
+		return -1
+	}
+	for i := 0; i < len(a.prerelease) && i < len(b.prerelease); i++ {
+		if result := compareIdentifier(a.prerelease[i], b.prerelease[i]); result != 0 {
+			return result
+		}
+	}
+	return len(a.prerelease) - len(b.prerelease)
+}
+
+// compareIdentifier compares pre-release identifiers
+// Numeric identifiers compare numerically and below alphanumeric ones.
+func compareIdentifier(a string, b string) int {
+	an, aErr := strconv.ParseUint(a, 10, 64)
+	bn, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		switch {
+		case an < bn:
+			return -1
+		case an > bn:
+			return 1
+		}
+		return 0
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// SemverCompare compares version strings by semantic versioning precedence
+// A leading "v" is allowed and build metadata is ignored, so "1.0.0+a"
+// and "v1.0.0+b" are equal. Strings that are not valid versions sort
+// before all valid ones, in lexicographic order among themselves.
+func SemverCompare(value interface{}, other interface{}) int {
+	a, aOK := parseSemver(value.(string))
+	b, bOK := parseSemver(other.(string))
+	switch {
+	case aOK && bOK:
+		return compareSemver(a, b)
+	case aOK:
+		return 1
+	case bOK:
+		return -1
+	}
+	return strings.Compare(value.(string), other.(string))
+}
+
+// Semantic version versions of Smaller and Larger for version strings
+func SemverSmaller(value interface{}, other interface{}) bool {
+	return SemverCompare(value, other) < 0
+}
+
+func SemverLarger(value interface{}, other interface{}) bool {
+	return SemverCompare(value, other) > 0
+}
+
+// NewSemverTree creates an initialized tree of version strings ordered by semver precedence
+// Values other than strings are rejected as if created WithType.
+// Time-complexity: O(1)
+func NewSemverTree(options ...Option) *Tree {
+	options = append([]Option{WithType(reflect.TypeFor[string]())}, options...)
+	return newTree(SemverSmaller, SemverLarger, SemverCompare, options...)
+}
@@ -0,0 +1,66 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSemverCompare(t *testing.T) {
+	// Ascending precedence, from the semver specification
+	ordered := []string{
+		"garbage",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"v1.0.1",
+		"1.2.0",
+		"1.10.0",
+		"2.0.0",
+	}
+	for i := range ordered {
+		for j := range ordered {
+			expected := 0
+			switch {
+			case i < j:
+				expected = -1
+			case i > j:
+				expected = 1
+			}
+			actual := SemverCompare(ordered[i], ordered[j])
+			if actual < 0 && expected >= 0 || actual > 0 && expected <= 0 || actual == 0 && expected != 0 {
+				t.Errorf("SemverCompare(%s, %s): {Expected: %d | Actual: %d}", ordered[i], ordered[j], expected, actual)
+			}
+		}
+	}
+	if actual := SemverCompare("1.0.0+build.1", "v1.0.0+build.2"); actual != 0 {
+		t.Errorf("SemverCompare(build metadata): {Expected: 0 | Actual: %d}", actual)
+	}
+	for _, invalid := range []string{"1.0", "01.0.0", "1.0.0-", "1.0.0-a..b", "1.x.0"} {
+		if SemverCompare(invalid, "0.0.0") >= 0 {
+			t.Errorf("SemverCompare(%s, 0.0.0): {Expected: < 0 | Actual: >= 0}", invalid)
+		}
+	}
+}
+
+// Keep releases sorted by precedence
+func ExampleNewSemverTree() {
+	tree := NewSemverTree()
+	for _, version := range []string{"1.10.0", "1.2.0", "1.2.0-rc.1", "v1.9.3"} {
+		tree.Insert(version)
+	}
+	fmt.Println(tree.Maximum())
+	tree.Traverse(InOrder, func(value interface{}) {
+		fmt.Println(value)
+	})
+	// Output:
+	// 1.10.0
+	// 1.2.0-rc.1
+	// 1.2.0
+	// v1.9.3
+	// 1.10.0
+}
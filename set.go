@@ -0,0 +1,89 @@
+package bstree
+
+// ItemIterator is called for each visited value; returning false stops the iteration
+type ItemIterator func(value interface{}) bool
+
+// Set is a sorted set backed by a tree
+// Its method set mirrors the naming of github.com/google/btree,
+// so code written against that library can switch backends easily.
+// Iterators run under the read lock and must not modify the set.
+type Set struct {
+	tree *Tree
+}
+
+// NewSet creates an initialized, empty set
+// Time-complexity: O(1)
+func NewSet(smaller Smaller, larger Larger, options ...Option) *Set {
+	return &Set{tree: New(smaller, larger, options...)}
+}
+
+// Tree returns the tree backing the set
+// Time-complexity: O(1)
+func (set *Set) Tree() *Tree {
+	return set.tree
+}
+
+// Add adds value to the set, returning false if it was already present
+// Average case time-complexity: O(depth)
+func (set *Set) Add(value interface{}) bool {
+	return set.tree.Insert(value)
+}
+
+// Remove removes value from the set, returning false if it was not present
+// Average case time-complexity: O(depth)
+func (set *Set) Remove(value interface{}) bool {
+	return set.tree.Delete(value)
+}
+
+// Has checks if value is in the set
+// Average case time-complexity: O(depth)
+func (set *Set) Has(value interface{}) bool {
+	return set.tree.Exists(value)
+}
+
+// Len returns the number of values in the set
+// Time-complexity: O(1)
+func (set *Set) Len() int {
+	return set.tree.Size()
+}
+
+// Each calls visitor on every value in ascending order
+// Time-complexity: O(size)
+func (set *Set) Each(visitor Visitor) {
+	set.tree.Traverse(InOrder, visitor)
+}
+
+// Ascend calls iterator on every value in ascending order until it returns false
+// Time-complexity: O(size)
+func (set *Set) Ascend(iterator ItemIterator) {
+	set.tree.rlock()
+	defer set.tree.mutex.RUnlock()
+	set.tree.doAscend(set.tree.root, Range{}, iterator)
+}
+
+// Descend calls iterator on every value in descending order until it returns false
+// Time-complexity: O(size)
+func (set *Set) Descend(iterator ItemIterator) {
+	set.tree.rlock()
+	defer set.tree.mutex.RUnlock()
+	set.tree.doDescend(set.tree.root, iterator)
+}
+
+// AscendRange calls iterator on the values in [greaterOrEqual, lessThan) in ascending order until it returns false
+// Average case time-complexity: O(depth + values in range)
+func (set *Set) AscendRange(greaterOrEqual interface{}, lessThan interface{}, iterator ItemIterator) {
+	set.tree.rlock()
+	defer set.tree.mutex.RUnlock()
+	set.tree.doAscend(set.tree.root, Range{GTE: greaterOrEqual, LT: lessThan}, iterator)
+}
+
+// doDescend visits all values in reverse order
+// Returns false if the iteration was stopped.
+func (tree *Tree) doDescend(node *_Node, iterator ItemIterator) bool {
+	if node == nil {
+		return true
+	}
+	return tree.doDescend(node.right(), iterator) &&
+		iterator(node.value) &&
+		tree.doDescend(node.left, iterator)
+}
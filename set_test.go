@@ -0,0 +1,71 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSet_AscendRange(t *testing.T) {
+	set := NewSet(IntSmaller, IntLarger)
+	for i := 0; i < 100; i += 3 {
+		set.Add(i)
+	}
+	for lo := -2; lo < 102; lo += 5 {
+		for hi := lo - 2; hi < 102; hi += 7 {
+			var expected, actual []interface{}
+			for i := 0; i < 100; i += 3 {
+				if i >= lo && i < hi {
+					expected = append(expected, i)
+				}
+			}
+			set.AscendRange(lo, hi, func(value interface{}) bool {
+				actual = append(actual, value)
+				return true
+			})
+			if fmt.Sprint(expected) != fmt.Sprint(actual) {
+				t.Errorf("AscendRange(%d, %d): {Expected: %v | Actual: %v}", lo, hi, expected, actual)
+			}
+		}
+	}
+}
+
+func TestSet_Stop(t *testing.T) {
+	set := NewSet(IntSmaller, IntLarger)
+	for i := 0; i < 100; i++ {
+		set.Add(i)
+	}
+	count := 0
+	set.Ascend(func(value interface{}) bool {
+		count++
+		return value.(int) < 9
+	})
+	if expected := 10; expected != count {
+		t.Errorf("Ascend visits: {Expected: %d | Actual: %d}", expected, count)
+	}
+	count = 0
+	set.Descend(func(value interface{}) bool {
+		count++
+		return value.(int) > 95
+	})
+	if expected := 5; expected != count {
+		t.Errorf("Descend visits: {Expected: %d | Actual: %d}", expected, count)
+	}
+}
+
+// Use the set like a google/btree
+func ExampleSet() {
+	set := NewSet(IntSmaller, IntLarger)
+	for _, value := range []int{5, 1, 4, 2, 3} {
+		set.Add(value)
+	}
+	set.Remove(4)
+	fmt.Println(set.Len(), set.Has(3), set.Has(4))
+	set.Descend(func(value interface{}) bool {
+		fmt.Printf("%d,", value)
+		return true
+	})
+	fmt.Printf("\n")
+	// Output:
+	// 4 true false
+	// 5,3,2,1,
+}
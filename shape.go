@@ -0,0 +1,45 @@
+package bstree
+
+// Width returns the largest number of nodes on any level of the tree
+// Time-complexity: O(size)
+func (tree *Tree) Width() int {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	return tree.width()
+}
+
+func (tree *Tree) width() int {
+	width := 0
+	tree.doLevels(func(level int, nodes []*_Node) {
+		width = max(width, len(nodes))
+	})
+	return width
+}
+
+// Diameter returns the number of edges on the longest path between any two nodes
+// A degenerate tree has a diameter of size-1, a balanced one about 2*log2(size).
+// Time-complexity: O(size)
+func (tree *Tree) Diameter() int {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	return tree.diameter()
+}
+
+func (tree *Tree) diameter() int {
+	diameter := 0
+	tree.doDiameter(tree.root, &diameter)
+	return diameter
+}
+
+// doDiameter returns the depth of the subtree rooted at node
+// The longest path through node joins the deepest paths of both subtrees;
+// diameter is raised to its length.
+func (tree *Tree) doDiameter(node *_Node, diameter *int) int {
+	if node == nil {
+		return 0
+	}
+	left := tree.doDiameter(node.left, diameter)
+	right := tree.doDiameter(node.right(), diameter)
+	*diameter = max(*diameter, left+right)
+	return 1 + max(left, right)
+}
@@ -0,0 +1,40 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_WidthAndDiameter(t *testing.T) {
+	for _, test := range []struct {
+		values   []int
+		width    int
+		diameter int
+	}{
+		{nil, 0, 0},
+		{[]int{1}, 1, 0},
+		{[]int{1, 2, 3, 4}, 1, 3},
+		{[]int{4, 2, 6, 1, 3, 5, 7}, 4, 4},
+		// The longest path doesn't pass through the root
+		{[]int{10, 5, 11, 3, 7, 2, 8, 1, 9}, 2, 6},
+	} {
+		tree := New(IntSmaller, IntLarger)
+		for _, value := range test.values {
+			tree.Insert(value)
+		}
+		if test.width != tree.Width() {
+			t.Errorf("Width of %v: {Expected: %d | Actual: %d}", test.values, test.width, tree.Width())
+		}
+		if test.diameter != tree.Diameter() {
+			t.Errorf("Diameter of %v: {Expected: %d | Actual: %d}", test.values, test.diameter, tree.Diameter())
+		}
+	}
+}
+
+// Measure the shape of a complete tree
+func ExampleTree_Diameter() {
+	tree := CompleteTree(15)
+	fmt.Println(tree.Width(), tree.Diameter())
+	// Output:
+	// 8 6
+}
@@ -0,0 +1,117 @@
+package bstree
+
+import "sync"
+
+// Sharded partitions values across several independent trees
+// Each value lives in the shard chosen by a user-supplied function, so
+// writers to different shards don't contend for the same lock.
+type Sharded struct {
+	shards []*Tree
+	shard  func(value interface{}) int
+}
+
+// NewSharded creates n empty shards, all using the same comparators and options
+// shard maps a value to its shard; the result is taken modulo n. Equal
+// values must map to the same shard. With a shard function that is
+// monotone in the ordering, e.g. a range split, the shards together
+// are in sorted order.
+// Time-complexity: O(n)
+func NewSharded(n int, shard func(value interface{}) int, smaller Smaller, larger Larger, options ...Option) *Sharded {
+	if n < 1 {
+		n = 1
+	}
+	sharded := &Sharded{shards: make([]*Tree, n), shard: shard}
+	for i := range sharded.shards {
+		sharded.shards[i] = New(smaller, larger, options...)
+	}
+	return sharded
+}
+
+// Shard returns the tree holding value
+// Time-complexity: O(1)
+func (sharded *Sharded) Shard(value interface{}) *Tree {
+	return sharded.shards[sharded.index(value)]
+}
+
+func (sharded *Sharded) index(value interface{}) int {
+	i := sharded.shard(value) % len(sharded.shards)
+	if i < 0 {
+		i += len(sharded.shards)
+	}
+	return i
+}
+
+// Shards returns all shards in order
+// Time-complexity: O(1)
+func (sharded *Sharded) Shards() []*Tree {
+	return sharded.shards
+}
+
+// Insert adds value to its shard if it doesn't already exist
+// Average case time-complexity: O(depth of the shard)
+func (sharded *Sharded) Insert(value interface{}) bool {
+	return sharded.Shard(value).Insert(value)
+}
+
+// InsertBatch adds values using one goroutine per shard and returns the number inserted
+// Time-complexity: O(len(values) * depth / shards) on enough cores
+func (sharded *Sharded) InsertBatch(values []interface{}) int {
+	batches := make([][]interface{}, len(sharded.shards))
+	for _, value := range values {
+		i := sharded.index(value)
+		batches[i] = append(batches[i], value)
+	}
+	inserted := make([]int, len(sharded.shards))
+	var wg sync.WaitGroup
+	for i, batch := range batches {
+		if len(batch) == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, batch []interface{}) {
+			defer wg.Done()
+			for _, value := range batch {
+				if sharded.shards[i].Insert(value) {
+					inserted[i]++
+				}
+			}
+		}(i, batch)
+	}
+	wg.Wait()
+	total := 0
+	for _, count := range inserted {
+		total += count
+	}
+	return total
+}
+
+// Exists checks if value exists in its shard
+// Average case time-complexity: O(depth of the shard)
+func (sharded *Sharded) Exists(value interface{}) bool {
+	return sharded.Shard(value).Exists(value)
+}
+
+// Delete removes value from its shard
+// Average case time-complexity: O(depth of the shard)
+func (sharded *Sharded) Delete(value interface{}) bool {
+	return sharded.Shard(value).Delete(value)
+}
+
+// Size returns the total number of values in all shards
+// Time-complexity: O(shards)
+func (sharded *Sharded) Size() int {
+	size := 0
+	for _, tree := range sharded.shards {
+		size += tree.Size()
+	}
+	return size
+}
+
+// Traverse walks every shard in turn using the given algorithm
+// Each shard is locked separately, so the walk is not atomic across shards.
+// Time-complexity: O(size)
+func (sharded *Sharded) Traverse(traversal Traversal, visitor Visitor) {
+	for _, tree := range sharded.shards {
+		tree.Traverse(traversal, visitor)
+	}
+}
@@ -0,0 +1,65 @@
+package bstree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestSharded(t *testing.T) {
+	sharded := NewSharded(4, func(value interface{}) int { return value.(int) }, IntSmaller, IntLarger)
+	var values []interface{}
+	for _, value := range rand.Perm(1000) {
+		values = append(values, value-500)
+	}
+	if inserted := sharded.InsertBatch(values); 1000 != inserted {
+		t.Errorf("InsertBatch: {Expected: 1000 | Actual: %d}", inserted)
+	}
+	if sharded.Insert(7) {
+		t.Errorf("Insert(7): {Expected: false | Actual: true}")
+	}
+	for i := -500; i < 500; i += 2 {
+		sharded.Delete(i)
+	}
+	if expected := 500; expected != sharded.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, sharded.Size())
+	}
+	for i := -500; i < 500; i++ {
+		if expected := i%2 != 0; expected != sharded.Exists(i) {
+			t.Errorf("Exists(%d): {Expected: %t | Actual: %t}", i, expected, !expected)
+		}
+	}
+	for i, tree := range sharded.Shards() {
+		tree.Traverse(InOrder, func(value interface{}) {
+			if sharded.index(value) != i {
+				t.Errorf("Shard of %d: {Expected: %d | Actual: %d}", value, sharded.index(value), i)
+			}
+		})
+	}
+}
+
+// Split values into sorted ranges
+func ExampleSharded() {
+	sharded := NewSharded(3, func(value interface{}) int { return value.(int) / 10 }, IntSmaller, IntLarger)
+	for _, value := range []int{25, 3, 14, 7, 21, 12} {
+		sharded.Insert(value)
+	}
+	sharded.Traverse(InOrder, func(value interface{}) {
+		fmt.Printf("%d,", value)
+	})
+	fmt.Printf("\n")
+	// Output:
+	// 3,7,12,14,21,25,
+}
+
+// Benchmark parallel ingest into sharded trees
+func BenchmarkShardedInsertBatch(b *testing.B) {
+	b.StopTimer()
+	values := make([]interface{}, b.N)
+	for i := range values {
+		values[i] = rand.Int()
+	}
+	sharded := NewSharded(8, func(value interface{}) int { return value.(int) }, IntSmaller, IntLarger)
+	b.StartTimer()
+	sharded.InsertBatch(values)
+}
@@ -0,0 +1,28 @@
+package bstree
+
+import "time"
+
+// _SlowLog reports operations that take longer than a threshold
+type _SlowLog struct {
+	threshold time.Duration
+	logger    func(op string, d time.Duration, size int)
+}
+
+// WithSlowOpLogger reports calls to Insert, Exists and Traverse that take longer than threshold
+// logger receives the name of the operation, its duration and the size of
+// the tree afterwards. Slow lookups on a tree that should be small hint at
+// a degenerate shape, e.g. from sorted inserts into an unbalanced tree.
+// The duration includes waiting for the lock and, for Traverse, the
+// visitor. logger is called without holding the lock.
+func WithSlowOpLogger(threshold time.Duration, logger func(op string, d time.Duration, size int)) Option {
+	return func(tree *Tree) {
+		tree.slowLog = &_SlowLog{threshold: threshold, logger: logger}
+	}
+}
+
+// observe calls the logger if the operation started at start took too long
+func (tree *Tree) observe(op string, start time.Time) {
+	if d := time.Since(start); d > tree.slowLog.threshold {
+		tree.slowLog.logger(op, d, tree.Size())
+	}
+}
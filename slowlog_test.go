@@ -0,0 +1,43 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestWithSlowOpLogger(t *testing.T) {
+	var ops []string
+	tree := New(IntSmaller, IntLarger, WithSlowOpLogger(10*time.Millisecond, func(op string, d time.Duration, size int) {
+		if d <= 10*time.Millisecond {
+			t.Errorf("Duration of %s: {Expected: > 10ms | Actual: %v}", op, d)
+		}
+		ops = append(ops, fmt.Sprintf("%s %d", op, size))
+	}))
+	tree.Insert(1)
+	tree.Exists(1)
+	tree.Traverse(InOrder, func(value interface{}) {})
+	if len(ops) != 0 {
+		t.Errorf("Fast operations: {Expected: [] | Actual: %v}", ops)
+	}
+	tree.Traverse(InOrder, func(value interface{}) {
+		time.Sleep(20 * time.Millisecond)
+	})
+	if expected := "[Traverse 1]"; expected != fmt.Sprint(ops) {
+		t.Errorf("Slow operations: {Expected: %s | Actual: %v}", expected, ops)
+	}
+}
+
+// Report traversals slower than a millisecond
+func ExampleWithSlowOpLogger() {
+	tree := New(IntSmaller, IntLarger, WithSlowOpLogger(time.Millisecond, func(op string, d time.Duration, size int) {
+		fmt.Printf("slow %s on %d values\n", op, size)
+	}))
+	tree.Insert(1)
+	tree.Insert(2)
+	tree.Traverse(InOrder, func(value interface{}) {
+		time.Sleep(2 * time.Millisecond)
+	})
+	// Output:
+	// slow Traverse on 2 values
+}
@@ -0,0 +1,45 @@
+package bstree
+
+import "sync/atomic"
+
+// _Snapshot publishes a frozen copy of a tree that readers can use without locking
+type _Snapshot struct {
+	frozen atomic.Pointer[Frozen]
+	reads  atomic.Int64 // locked reads since the last modification
+}
+
+// WithReadSnapshot enables a lock-free fast path for Exists
+// Once a tree has served as many locked lookups as it has values without
+// being modified, a frozen copy is published and subsequent lookups use
+// it without touching the lock. Any modification discards the copy.
+// This makes "load once, query many" workloads scale with the number of
+// cores at the cost of an amortized O(1) copying overhead per lookup.
+func WithReadSnapshot() Option {
+	return func(tree *Tree) {
+		tree.snapshot = new(_Snapshot)
+	}
+}
+
+// read counts a locked read and publishes a new frozen copy once the
+// number of reads pays for building it. It has to be called with the
+// read lock held, which guarantees no modification can slip in between
+// freezing and publishing.
+func (snapshot *_Snapshot) read(tree *Tree) {
+	// A copy would miss values pending in an insert buffer
+	if snapshot == nil || tree.buffer.len() > 0 {
+		return
+	}
+	if snapshot.reads.Add(1) == int64(tree.size)+1 {
+		snapshot.frozen.Store(tree.freeze())
+	}
+}
+
+// invalidate discards the published copy. It has to be called with
+// the write lock held after every modification.
+func (snapshot *_Snapshot) invalidate() {
+	if snapshot == nil {
+		return
+	}
+	snapshot.frozen.Store(nil)
+	snapshot.reads.Store(0)
+}
@@ -0,0 +1,136 @@
+package bstree
+
+import (
+	"math/rand"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+func TestTree_ReadSnapshot(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithReadSnapshot())
+	for i := 0; i < 100; i += 2 {
+		tree.Insert(i)
+	}
+	// Enough lookups to publish the snapshot
+	for i := 0; i < 200; i++ {
+		if expected := i%2 == 0 && i < 100; expected != tree.Exists(i) {
+			t.Fatalf("Exists(%d): {Expected: %t | Actual: %t}", i, expected, !expected)
+		}
+	}
+	if tree.snapshot.frozen.Load() == nil {
+		t.Fatalf("Snapshot: {Expected: published | Actual: <nil>}")
+	}
+	tree.Insert(1)
+	if !tree.Exists(1) {
+		t.Errorf("Exists(1) after Insert: {Expected: true | Actual: false}")
+	}
+	tree.Delete(2)
+	if tree.Exists(2) {
+		t.Errorf("Exists(2) after Delete: {Expected: false | Actual: true}")
+	}
+}
+
+func TestTree_ReadSnapshotParallel(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithReadSnapshot())
+	for i := 0; i < 1000; i += 2 {
+		tree.Insert(i)
+	}
+	var wg sync.WaitGroup
+	for g := 0; g < runtime.NumCPU(); g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 10000; i++ {
+				// Even values are never deleted, large odd values never inserted
+				value := rand.Intn(500) * 2
+				if !tree.Exists(value) {
+					t.Errorf("Exists(%d): {Expected: true | Actual: false}", value)
+					return
+				}
+				if tree.Exists(value + 1000001) {
+					t.Errorf("Exists(%d): {Expected: false | Actual: true}", value+1000001)
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 100; i++ {
+		tree.Insert(1001 + 2*i)
+		tree.Delete(1001 + 2*i)
+	}
+	wg.Wait()
+}
+
+// withWriter runs a goroutine modifying tree until the returned function is called
+func withWriter(tree *Tree, max int) func() {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			value := rand.Intn(max)
+			tree.Insert(value)
+			tree.Delete(value)
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+func benchmarkExistsParallel(b *testing.B, tree *Tree, writer bool) {
+	b.StopTimer()
+	for _, value := range rand.Perm(50000) {
+		tree.Insert(value * 2)
+	}
+	if writer {
+		defer withWriter(tree, 100000)()
+	}
+	b.StartTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		rng := rand.New(rand.NewSource(rand.Int63()))
+		for pb.Next() {
+			tree.Exists(rng.Intn(100000))
+		}
+	})
+}
+
+// Benchmark concurrent lookups without writers
+func BenchmarkTreeExistsParallel(b *testing.B) {
+	benchmarkExistsParallel(b, EmptyTree(), false)
+}
+
+// Benchmark concurrent lookups with the read snapshot fast path
+func BenchmarkTreeExistsParallelSnapshot(b *testing.B) {
+	benchmarkExistsParallel(b, New(IntSmaller, IntLarger, WithReadSnapshot()), false)
+}
+
+// Benchmark concurrent lookups under writer load
+func BenchmarkTreeExistsParallelWriter(b *testing.B) {
+	benchmarkExistsParallel(b, EmptyTree(), true)
+}
+
+// Benchmark concurrent lookups under writer load with the read snapshot enabled
+func BenchmarkTreeExistsParallelWriterSnapshot(b *testing.B) {
+	benchmarkExistsParallel(b, New(IntSmaller, IntLarger, WithReadSnapshot()), true)
+}
+
+// Benchmark concurrent traversals under writer load
+func BenchmarkTreeTraverseParallelWriter(b *testing.B) {
+	b.StopTimer()
+	tree := RandomTree(1000, 10000)
+	defer withWriter(tree, 10000)()
+	b.StartTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			tree.Traverse(InOrder, func(value interface{}) {})
+		}
+	})
+}
@@ -0,0 +1,292 @@
+package bstree
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"sync"
+)
+
+// ErrUnsupportedOption is returned by NewSpillTree for options that only make sense for a whole tree
+var ErrUnsupportedOption = errors.New("bstree: option not supported by SpillTree")
+
+// SpillTree is a tree that keeps at most a fixed number of values in memory
+// The values are partitioned by range into pages, each held by a regular
+// tree. When more values than the limit are in memory, the least recently
+// used pages are written to a temporary file and read back when they are
+// accessed again, which makes it possible to index more values than fit
+// into memory. Values are encoded using encoding/gob, so custom value
+// types have to be registered using gob.Register.
+// All methods are safe for concurrent use, but serialized by a single lock.
+type SpillTree struct {
+	mutex    sync.Mutex
+	smaller  Smaller
+	larger   Larger
+	less     Smaller // smaller as wrapped by the options, e.g. WithNilPolicy
+	options  []Option
+	limit    int // maximum number of values in memory
+	pageSize int // number of values above which a page is split
+	file     *os.File
+	end      int64 // offset at which the next page is written
+	pages    []*_Page
+	resident int // number of values in memory
+	size     int
+	clock    uint64
+}
+
+// _Page holds the values of a SpillTree from lower up to the lower bound of the next page
+type _Page struct {
+	lower  interface{} // smallest value belonging to the page, unused for the first page
+	tree   *Tree       // nil while the page is spilled
+	size   int
+	offset int64 // location of the spilled copy in the file
+	length int64 // 0 if the page was never spilled
+	dirty  bool  // modified since it was last spilled
+	used   uint64
+}
+
+// NewSpillTree creates an empty tree keeping at most limit values in memory
+// The temporary file is created in dir, or the default directory for
+// temporary files if dir is empty, and removed by Close. The options
+// configure the tree of every page. WithDuplicates, WithInsertBuffer and
+// WithWAL would apply to each page on its own instead of the whole tree
+// and fail with ErrUnsupportedOption.
+// Time-complexity: O(1)
+func NewSpillTree(smaller Smaller, larger Larger, limit int, dir string, options ...Option) (*SpillTree, error) {
+	first := New(smaller, larger, options...)
+	if first.duplicates || first.buffer != nil || first.wal != nil {
+		return nil, ErrUnsupportedOption
+	}
+	file, err := os.CreateTemp(dir, "bstree-spill-*")
+	if err != nil {
+		return nil, err
+	}
+	if limit < 4 {
+		limit = 4
+	}
+	spill := &SpillTree{
+		smaller:  smaller,
+		larger:   larger,
+		less:     first.smaller,
+		options:  options,
+		limit:    limit,
+		pageSize: limit / 4,
+		file:     file,
+	}
+	spill.pages = []*_Page{{tree: first, dirty: true}}
+	return spill, nil
+}
+
+// Close removes the temporary file
+// The tree must not be used afterwards.
+func (spill *SpillTree) Close() error {
+	spill.mutex.Lock()
+	defer spill.mutex.Unlock()
+	err := spill.file.Close()
+	if removeErr := os.Remove(spill.file.Name()); err == nil {
+		err = removeErr
+	}
+	return err
+}
+
+// Size returns the number of values in the tree
+// Time-complexity: O(1)
+func (spill *SpillTree) Size() int {
+	spill.mutex.Lock()
+	defer spill.mutex.Unlock()
+	return spill.size
+}
+
+// Resident returns the number of values currently held in memory
+// Time-complexity: O(1)
+func (spill *SpillTree) Resident() int {
+	spill.mutex.Lock()
+	defer spill.mutex.Unlock()
+	return spill.resident
+}
+
+// Insert adds value to the tree if it doesn't already exist
+// The error reports a failure to read or write the temporary file.
+// Average case time-complexity: O(depth), plus O(pageSize) to page in
+func (spill *SpillTree) Insert(value interface{}) (bool, error) {
+	spill.mutex.Lock()
+	defer spill.mutex.Unlock()
+	index := spill.find(value)
+	page := spill.pages[index]
+	if err := spill.pageIn(page); err != nil {
+		return false, err
+	}
+	if !page.tree.Insert(value) {
+		return false, nil
+	}
+	page.size++
+	page.dirty = true
+	spill.size++
+	spill.resident++
+	if page.size > spill.pageSize {
+		spill.split(index)
+	}
+	return true, spill.evict()
+}
+
+// Exists checks if a value exists in the tree
+// The error reports a failure to read or write the temporary file.
+// Average case time-complexity: O(depth), plus O(pageSize) to page in
+func (spill *SpillTree) Exists(value interface{}) (bool, error) {
+	spill.mutex.Lock()
+	defer spill.mutex.Unlock()
+	page := spill.pages[spill.find(value)]
+	if err := spill.pageIn(page); err != nil {
+		return false, err
+	}
+	return page.tree.Exists(value), spill.evict()
+}
+
+// Delete removes a value from the tree
+// The error reports a failure to read or write the temporary file.
+// Average case time-complexity: O(depth), plus O(pageSize) to page in
+func (spill *SpillTree) Delete(value interface{}) (bool, error) {
+	spill.mutex.Lock()
+	defer spill.mutex.Unlock()
+	index := spill.find(value)
+	page := spill.pages[index]
+	if err := spill.pageIn(page); err != nil {
+		return false, err
+	}
+	if !page.tree.Delete(value) {
+		return false, spill.evict()
+	}
+	page.size--
+	page.dirty = true
+	spill.size--
+	spill.resident--
+	if page.size == 0 && len(spill.pages) > 1 {
+		spill.pages = append(spill.pages[:index], spill.pages[index+1:]...)
+	}
+	return true, spill.evict()
+}
+
+// Traverse calls visitor on every value in ascending order
+// Spilled pages are read without being kept in memory.
+// Time-complexity: O(size)
+func (spill *SpillTree) Traverse(visitor Visitor) error {
+	spill.mutex.Lock()
+	defer spill.mutex.Unlock()
+	for _, page := range spill.pages {
+		if page.tree != nil {
+			page.tree.Traverse(InOrder, visitor)
+			continue
+		}
+		values, err := spill.read(page)
+		if err != nil {
+			return err
+		}
+		for _, value := range values {
+			visitor(value)
+		}
+	}
+	return nil
+}
+
+// find returns the index of the page value belongs to
+func (spill *SpillTree) find(value interface{}) int {
+	return sort.Search(len(spill.pages)-1, func(i int) bool {
+		return spill.less(value, spill.pages[i+1].lower)
+	})
+}
+
+// split divides a page that grew too large into two resident pages
+func (spill *SpillTree) split(index int) {
+	page := spill.pages[index]
+	page.tree.rlock()
+	values := page.tree.values()
+	page.tree.mutex.RUnlock()
+	mid := len(values) / 2
+	right := &_Page{lower: values[mid], tree: spill.build(values[mid:]), size: len(values) - mid, dirty: true, used: page.used}
+	page.tree = spill.build(values[:mid])
+	page.size = mid
+	spill.pages = append(spill.pages, nil)
+	copy(spill.pages[index+2:], spill.pages[index+1:])
+	spill.pages[index+1] = right
+}
+
+// build creates the tree of a page from sorted values
+func (spill *SpillTree) build(values []interface{}) *Tree {
+	tree := New(spill.smaller, spill.larger, spill.options...)
+	tree.mutex.Lock()
+	tree.load(values)
+	tree.mutex.Unlock()
+	return tree
+}
+
+// pageIn makes a page resident and marks it as most recently used
+func (spill *SpillTree) pageIn(page *_Page) error {
+	spill.clock++
+	page.used = spill.clock
+	if page.tree != nil {
+		return nil
+	}
+	values, err := spill.read(page)
+	if err != nil {
+		return err
+	}
+	page.tree = spill.build(values)
+	spill.resident += page.size
+	return nil
+}
+
+// read decodes the values of a spilled page
+func (spill *SpillTree) read(page *_Page) ([]interface{}, error) {
+	var values []interface{}
+	section := io.NewSectionReader(spill.file, page.offset, page.length)
+	if err := gob.NewDecoder(section).Decode(&values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// evict spills the least recently used pages until the resident values fit the limit
+// The most recently used page always stays in memory.
+func (spill *SpillTree) evict() error {
+	for spill.resident > spill.limit {
+		var coldest *_Page
+		for _, page := range spill.pages {
+			if page.tree != nil && page.used != spill.clock && (coldest == nil || page.used < coldest.used) {
+				coldest = page
+			}
+		}
+		if coldest == nil {
+			return nil
+		}
+		if err := spill.pageOut(coldest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pageOut writes a page to the file unless an up-to-date copy exists, then drops it from memory
+// Space of outdated copies is only reclaimed by Close.
+func (spill *SpillTree) pageOut(page *_Page) error {
+	if page.dirty || page.length == 0 {
+		page.tree.rlock()
+		values := page.tree.values()
+		page.tree.mutex.RUnlock()
+		var buffer bytes.Buffer
+		if err := gob.NewEncoder(&buffer).Encode(values); err != nil {
+			return err
+		}
+		if _, err := spill.file.WriteAt(buffer.Bytes(), spill.end); err != nil {
+			return err
+		}
+		page.offset, page.length = spill.end, int64(buffer.Len())
+		spill.end += page.length
+		page.dirty = false
+	}
+	page.tree = nil
+	spill.resident -= page.size
+	return nil
+}
@@ -0,0 +1,111 @@
+package bstree
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+func TestSpillTree(t *testing.T) {
+	spill, err := NewSpillTree(IntSmaller, IntLarger, 100, t.TempDir())
+	if err != nil {
+		t.Fatalf("NewSpillTree: {Expected: <nil> | Actual: %v}", err)
+	}
+	defer spill.Close()
+	rng := rand.New(rand.NewSource(1))
+	values := rng.Perm(2000)
+	for _, value := range values {
+		if inserted, err := spill.Insert(value); !inserted || err != nil {
+			t.Fatalf("Insert(%d): {Expected: true <nil> | Actual: %t %v}", value, inserted, err)
+		}
+		if spill.Resident() > 100 {
+			t.Fatalf("Resident: {Expected: <= 100 | Actual: %d}", spill.Resident())
+		}
+	}
+	for _, value := range values[:1000] {
+		if deleted, err := spill.Delete(value); !deleted || err != nil {
+			t.Fatalf("Delete(%d): {Expected: true <nil> | Actual: %t %v}", value, deleted, err)
+		}
+	}
+	for i, value := range values {
+		if exists, err := spill.Exists(value); exists != (i >= 1000) || err != nil {
+			t.Errorf("Exists(%d): {Expected: %t <nil> | Actual: %t %v}", value, i >= 1000, exists, err)
+		}
+	}
+	if expected := 1000; expected != spill.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, spill.Size())
+	}
+	previous, count := -1, 0
+	spill.Traverse(func(value interface{}) {
+		if value.(int) <= previous {
+			t.Errorf("Traverse: {Expected: > %d | Actual: %v}", previous, value)
+		}
+		previous = value.(int)
+		count++
+	})
+	if expected := 1000; expected != count {
+		t.Errorf("Traverse count: {Expected: %d | Actual: %d}", expected, count)
+	}
+}
+
+func TestSpillTree_NilFirst(t *testing.T) {
+	spill, err := NewSpillTree(IntSmaller, IntLarger, 8, t.TempDir(), WithNilPolicy(NilFirst))
+	if err != nil {
+		t.Fatalf("NewSpillTree: {Expected: <nil> | Actual: %v}", err)
+	}
+	defer spill.Close()
+	// Enough values for several pages, so nil is looked up among page bounds
+	for value := 0; value < 50; value++ {
+		spill.Insert(value)
+	}
+	if inserted, err := spill.Insert(nil); !inserted || err != nil {
+		t.Fatalf("Insert(nil): {Expected: true <nil> | Actual: %t %v}", inserted, err)
+	}
+	if exists, err := spill.Exists(nil); !exists || err != nil {
+		t.Errorf("Exists(nil): {Expected: true <nil> | Actual: %t %v}", exists, err)
+	}
+	var first interface{} = -1
+	spill.Traverse(func(value interface{}) {
+		if first == -1 {
+			first = value
+		}
+	})
+	if first != nil {
+		t.Errorf("First value: {Expected: <nil> | Actual: %v}", first)
+	}
+}
+
+func TestNewSpillTree_UnsupportedOptions(t *testing.T) {
+	for name, option := range map[string]Option{
+		"WithDuplicates":   WithDuplicates(),
+		"WithInsertBuffer": WithInsertBuffer(4),
+		"WithWAL":          WithWAL(io.Discard),
+	} {
+		spill, err := NewSpillTree(IntSmaller, IntLarger, 100, t.TempDir(), option)
+		if !errors.Is(err, ErrUnsupportedOption) {
+			t.Errorf("%s: {Expected: %v | Actual: %v}", name, ErrUnsupportedOption, err)
+		}
+		if spill != nil {
+			spill.Close()
+		}
+	}
+}
+
+// Index more values than are kept in memory
+func ExampleSpillTree() {
+	spill, err := NewSpillTree(IntSmaller, IntLarger, 16, "")
+	if err != nil {
+		panic(err)
+	}
+	defer spill.Close()
+	for i := 0; i < 100; i++ {
+		spill.Insert(i)
+	}
+	fmt.Println(spill.Size(), spill.Resident() <= 16)
+	fmt.Println(spill.Exists(42))
+	// Output:
+	// 100 true
+	// true <nil>
+}
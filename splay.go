@@ -0,0 +1,102 @@
+package bstree
+
+// Splay trees restructure themselves on every access: Insert, Delete and
+// Exists rotate the value they reach to the root, so frequently accessed
+// values gather near the root and skewed (e.g. Zipfian) lookups take far
+// fewer than log2(size) steps. The cost is amortized O(log(size)) per
+// access, but a single access may take O(size).
+//
+// Since Exists reshapes the tree, it takes the write lock and lookups on a
+// splay tree no longer run concurrently. Every reshaping counts as a
+// modification for Version, iterators and cursors. All other reads leave
+// the shape alone. Like LLRB trees, splay trees ignore WithAutoRebalance
+// and background maintenance.
+
+// splayExists implements Exists for splay trees
+// Average case time-complexity: O(log(size)) amortized
+// Worst case time-complexity: O(size)
+func (tree *Tree) splayExists(value interface{}, wait bool) (bool, error) {
+	if err := tree.acquire("Exists", wait); err != nil {
+		return false, err
+	}
+	defer tree.mutex.Unlock()
+	tree.flush()
+	if !tree.bloom.mayContain(value) {
+		return false, nil
+	}
+	tree.snapshot.read(tree)
+	pre := tree.preKey(value)
+	tree.splayTo(value, pre)
+	tree.verify()
+	return tree.root != nil && tree.order(value, pre, tree.root) == 0, nil
+}
+
+// splayTo rotates the node holding value, or else the last node on its search path, to the root
+// With duplicates, the topmost of the equal values moves to the root. pre is
+// the pre-key of value, see preKey.
+func (tree *Tree) splayTo(value interface{}, pre uint64) {
+	if tree.root == nil {
+		return
+	}
+	root := tree.splay(tree.root, value, pre)
+	if root != tree.root {
+		tree.root = root
+		tree.orphanRoot()
+		tree.version++
+	}
+}
+
+// splay rotates the node holding value, or else the last node on its search path, to the root of the subtree
+// All comparisons happen on the way down and all rotations on the way up,
+// so a panicking comparator leaves the tree intact. pre is the pre-key of value.
+func (tree *Tree) splay(node *_Node, value interface{}, pre uint64) *_Node {
+	switch order := tree.order(value, pre, node); {
+	case order < 0 && node.left != nil:
+		child := node.left
+		switch order := tree.order(value, pre, child); {
+		case order < 0 && child.left != nil:
+			// Zig-zig: rotate the grandparent first
+			child.left = tree.splay(child.left, value, pre)
+			node = tree.splayRight(node)
+		case order > 0 && child.right() != nil:
+			// Zig-zag
+			tree.setRight(child, tree.splay(child.right(), value, pre), nil)
+			node.left = tree.splayLeft(child)
+		}
+		return tree.splayRight(node)
+	case order > 0 && node.right() != nil:
+		child := node.right()
+		switch order := tree.order(value, pre, child); {
+		case order > 0 && child.right() != nil:
+			tree.setRight(child, tree.splay(child.right(), value, pre), nil)
+			node = tree.splayLeft(node)
+		case order < 0 && child.left != nil:
+			child.left = tree.splay(child.left, value, pre)
+			tree.setRight(node, tree.splayRight(child), nil)
+		}
+		return tree.splayLeft(node)
+	}
+	return node
+}
+
+// splayLeft rotates the right child of node up and returns it
+// Unlike rotateLeft, it leaves the colors of the nodes alone.
+func (tree *Tree) splayLeft(node *_Node) *_Node {
+	right := node.right()
+	tree.setRight(node, right.left, right)
+	right.left = node
+	tree.update(node)
+	tree.update(right)
+	return right
+}
+
+// splayRight rotates the left child of node up and returns it
+// Unlike rotateRight, it leaves the colors of the nodes alone.
+func (tree *Tree) splayRight(node *_Node) *_Node {
+	left := node.left
+	node.left = left.right()
+	tree.setRight(left, node, nil)
+	tree.update(node)
+	tree.update(left)
+	return left
+}
@@ -0,0 +1,75 @@
+package bstree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestSplay_Exists(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithBalancing(Splay))
+	for i := 0; i < 1000; i++ {
+		tree.Insert(i)
+	}
+	for _, step := range []struct {
+		value   int
+		changed bool
+	}{{500, true}, {3, true}, {3, false}, {998, true}} {
+		version := tree.Version()
+		if !tree.Exists(step.value) {
+			t.Errorf("Exists(%d): {Expected: true | Actual: false}", step.value)
+		}
+		if path, _ := tree.PathTo(step.value); len(path) != 1 {
+			t.Errorf("Depth of %d: {Expected: 1 | Actual: %d}", step.value, len(path))
+		}
+		if changed := tree.Version() != version; changed != step.changed {
+			t.Errorf("Version changed by Exists(%d): {Expected: %t | Actual: %t}", step.value, step.changed, changed)
+		}
+	}
+	if tree.Exists(1000) {
+		t.Errorf("Exists(1000): {Expected: false | Actual: true}")
+	}
+	if root, _ := tree.PathTo(999); len(root) != 1 {
+		t.Errorf("Root after Exists(1000): {Expected: 999 | Actual: %v}", root)
+	}
+	mustCheck(t, tree)
+}
+
+func TestSplay_Skewed(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithBalancing(Splay))
+	for i := 0; i < 10000; i++ {
+		tree.Insert(i)
+	}
+	// Nine in ten lookups go to ten hot values
+	hot := rand.Perm(10000)[:10]
+	for i := 0; i < 10000; i++ {
+		if i%10 == 0 {
+			tree.Exists(rand.Intn(10000))
+		} else {
+			tree.Exists(hot[i%10])
+		}
+	}
+	total := 0
+	for _, value := range hot {
+		path, _ := tree.PathTo(value)
+		total += len(path)
+	}
+	if average := float64(total) / float64(len(hot)); average > 10 {
+		t.Errorf("Average depth of hot values: {Expected: <= 10 | Actual: %.1f}", average)
+	}
+	mustCheck(t, tree)
+}
+
+// Recently accessed values move to the root
+func ExampleSplay() {
+	tree := New(IntSmaller, IntLarger, WithBalancing(Splay))
+	for _, value := range []int{5, 2, 8, 1, 9, 3} {
+		tree.Insert(value)
+	}
+	tree.Exists(8)
+	tree.View(func(view ReadView) {
+		fmt.Println(view.Root().Value())
+	})
+	// Output:
+	// 8
+}
@@ -0,0 +1,22 @@
+package bstree
+
+import "fmt"
+
+// stringValues is the number of values shown by String
+const stringValues = 10
+
+// WithStringer sets how String and PrettyString format values
+// The default is fmt's %v.
+func WithStringer(stringer func(value interface{}) string) Option {
+	return func(tree *Tree) {
+		tree.stringer = stringer
+	}
+}
+
+// format formats a single value for display
+func (tree *Tree) format(value interface{}) string {
+	if tree.stringer == nil {
+		return fmt.Sprint(value)
+	}
+	return tree.stringer(value)
+}
@@ -0,0 +1,31 @@
+package bstree
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestTree_StringBounded(t *testing.T) {
+	tree := CompleteTree(1000)
+	expected := "{size: 1000 | depth: 10 | min: 1 | max: 1000 | values: [1 2 3 4 5 6 7 8 9 10 ...]}"
+	if actual := tree.String(); expected != actual {
+		t.Errorf("String: {Expected: %s | Actual: %s}", expected, actual)
+	}
+	if actual := EmptyTree().String(); "{size: 0}" != actual {
+		t.Errorf("String of empty tree: {Expected: {size: 0} | Actual: %s}", actual)
+	}
+}
+
+// Log a summary of a tree with custom value formatting
+func ExampleWithStringer() {
+	tree := New(IntSmaller, IntLarger, WithStringer(func(value interface{}) string {
+		return strings.Repeat("*", value.(int))
+	}))
+	tree.Insert(2)
+	tree.Insert(1)
+	tree.Insert(3)
+	fmt.Println(tree)
+	// Output:
+	// {size: 3 | depth: 2 | min: * | max: *** | values: [* ** ***]}
+}
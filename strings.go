@@ -0,0 +1,47 @@
+package bstree
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// StringFoldCompare compares strings case-insensitively
+// Runes are compared after mapping them to lower case, so "apple",
+// "Apple" and "APPLE" are equal and all sort before "banana".
+// Unlike comparing strings.ToLower results, it allocates nothing.
+// It folds case only and is no collation: accents and the rules of
+// locales are ignored. The package has no locale-aware comparators, as
+// they would need golang.org/x/text; wrap the CompareString method of a
+// collate.Collator in a Compare and pass it to NewCompare instead.
+func StringFoldCompare(value interface{}, other interface{}) int {
+	a, b := value.(string), other.(string)
+	for a != "" && b != "" {
+		ra, na := utf8.DecodeRuneInString(a)
+		rb, nb := utf8.DecodeRuneInString(b)
+		ra, rb = unicode.ToLower(ra), unicode.ToLower(rb)
+		if ra != rb {
+			if ra < rb {
+				return -1
+			}
+			return 1
+		}
+		a, b = a[na:], b[nb:]
+	}
+	switch {
+	case a == "" && b == "":
+		return 0
+	case a == "":
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Case-insensitive string versions of Smaller and Larger
+func StringFoldSmaller(value interface{}, other interface{}) bool {
+	return StringFoldCompare(value, other) < 0
+}
+
+func StringFoldLarger(value interface{}, other interface{}) bool {
+	return StringFoldCompare(value, other) > 0
+}
@@ -0,0 +1,36 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStringFoldCompare(t *testing.T) {
+	tests := []struct {
+		value, other string
+		expected     int
+	}{
+		{"apple", "APPLE", 0},
+		{"Apple", "banana", -1},
+		{"Banana", "apple", 1},
+		{"app", "Apple", -1},
+		{"ÉCOLE", "école", 0},
+		{"", "", 0},
+	}
+	for _, test := range tests {
+		if actual := StringFoldCompare(test.value, test.other); actual != test.expected {
+			t.Errorf("StringFoldCompare(%q, %q): {Expected: %d | Actual: %d}", test.value, test.other, test.expected, actual)
+		}
+	}
+}
+
+// Sort names ignoring case
+func ExampleStringFoldSmaller() {
+	tree := New(StringFoldSmaller, StringFoldLarger)
+	for _, name := range []string{"bob", "Alice", "alice", "Carol"} {
+		tree.Insert(name)
+	}
+	fmt.Println(tree)
+	// Output:
+	// {size: 3 | depth: 2 | min: Alice | max: Carol | values: [Alice bob Carol]}
+}
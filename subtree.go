@@ -0,0 +1,48 @@
+package bstree
+
+// Subtree returns a copy of the subtree rooted at the node holding rootValue
+// The copy has the same shape as the subtree and is configured like the
+// tree, see Filter. Returns false if no value equal to rootValue exists.
+// Trees created WithDuplicates copy the subtree of the topmost equal value.
+// Average case time-complexity: O(depth + size of the subtree)
+// Worst case time-complexity: O(size)
+func (tree *Tree) Subtree(rootValue interface{}) (*Tree, bool) {
+	defer tree.recoverPanic()
+	if tree.checkType(rootValue) != nil {
+		return nil, false
+	}
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	node := tree.find(rootValue)
+	if node == nil {
+		return nil, false
+	}
+	subtree := tree.sibling()
+	subtree.mutex.Lock()
+	defer subtree.mutex.Unlock()
+	subtree.root = subtree.copyNodes(node, nil)
+	// A red root is valid LLRB once it is black, as its left child is black
+	subtree.root.red = false
+	subtree.orphanRoot()
+	subtree.size = subtree.root.size
+	if subtree.bloom.full() {
+		subtree.rebuildBloom()
+	}
+	subtree.refreshExtremes()
+	subtree.verify()
+	return subtree, true
+}
+
+// copyNodes copies the subtree rooted at node, which belongs to another tree, into the tree
+// next is the in-order successor of the copy, see doInsert.
+func (tree *Tree) copyNodes(node *_Node, next *_Node) *_Node {
+	if node == nil {
+		return nil
+	}
+	copied := tree.allocNode(node.value)
+	copied.red = node.red
+	copied.left = tree.copyNodes(node.left, copied)
+	tree.setRight(copied, tree.copyNodes(node.right(), next), next)
+	tree.update(copied)
+	return copied
+}
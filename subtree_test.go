@@ -0,0 +1,48 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_Subtree(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend, WithThreads())
+		for i := 0; i < 100; i++ {
+			tree.Insert((50 + i*37) % 100)
+		}
+		var rootValue, leftValue interface{}
+		tree.View(func(view ReadView) {
+			rootValue, leftValue = view.Root().Value(), view.Root().Left().Value()
+		})
+		subtree, ok := tree.Subtree(leftValue)
+		if !ok {
+			t.Fatalf("Subtree(%v): {Expected: true | Actual: false}", leftValue)
+		}
+		mustCheck(t, subtree)
+		if expected := tree.CountRange(Range{LT: rootValue}); expected != subtree.Size() {
+			t.Errorf("Size: {Expected: %d | Actual: %d}", expected, subtree.Size())
+		}
+		if expected := fmt.Sprint(Values(tree)[:subtree.Size()]); expected != fmt.Sprint(Values(subtree)) {
+			t.Errorf("Values: {Expected: %s | Actual: %v}", expected, Values(subtree))
+		}
+		// The copy is independent of the tree
+		subtree.Insert(1000)
+		if tree.Exists(1000) {
+			t.Errorf("Exists(1000) in tree: {Expected: false | Actual: true}")
+		}
+		mustCheck(t, subtree)
+		if _, ok := tree.Subtree(-1); ok {
+			t.Errorf("Subtree(-1): {Expected: false | Actual: true}")
+		}
+	})
+}
+
+// Copy the right half of a tree
+func ExampleTree_Subtree() {
+	tree := CompleteTree(7)
+	subtree, _ := tree.Subtree(6)
+	fmt.Println(subtree)
+	// Output:
+	// {size: 3 | depth: 2 | min: 5 | max: 7 | values: [5 6 7]}
+}
@@ -0,0 +1,172 @@
+package bstree
+
+// MapEntry is a key-value pair stored in a SyncMap
+// Hooks, write-ahead logs and encoders of the backing tree see MapEntry values.
+type MapEntry struct {
+	Key   interface{}
+	Value interface{}
+}
+
+func mapEntryKey(value interface{}) interface{} {
+	return value.(MapEntry).Key
+}
+
+// SyncMap is an ordered map with the method set of sync.Map
+// It can replace a sync.Map where keys have to be visited in order:
+// Range visits keys in ascending order. Like with sync.Map, the function
+// passed to Range may modify the map, as the lock is only held per step.
+type SyncMap struct {
+	tree *Tree
+}
+
+// NewSyncMap creates an initialized, empty map ordering keys using smaller and larger
+// The options configure the backing tree, whose values are MapEntry structs.
+// Time-complexity: O(1)
+func NewSyncMap(smaller Smaller, larger Larger, options ...Option) *SyncMap {
+	return &SyncMap{tree: NewByKey(mapEntryKey, smaller, larger, options...)}
+}
+
+// Tree returns the tree backing the map
+// Time-complexity: O(1)
+func (m *SyncMap) Tree() *Tree {
+	return m.tree
+}
+
+// Load returns the value stored for key, or nil if there is none
+// ok reports whether a value was found.
+// Average case time-complexity: O(depth)
+func (m *SyncMap) Load(key interface{}) (value interface{}, ok bool) {
+	entry, ok := m.tree.GetByKey(key)
+	if !ok {
+		return nil, false
+	}
+	return entry.(MapEntry).Value, true
+}
+
+// Store sets the value for key
+// Average case time-complexity: O(depth)
+func (m *SyncMap) Store(key interface{}, value interface{}) {
+	m.Swap(key, value)
+}
+
+// Swap sets the value for key and returns the previous value, if any
+// loaded reports whether the key was present.
+// Average case time-complexity: O(depth)
+func (m *SyncMap) Swap(key interface{}, value interface{}) (previous interface{}, loaded bool) {
+	tree := m.tree
+	tree.lock()
+	defer tree.mutex.Unlock()
+	if node := tree.findKey(key); node != nil {
+		previous, loaded = node.value.(MapEntry).Value, true
+		m.remove(node.value)
+	}
+	m.add(MapEntry{key, value})
+	return previous, loaded
+}
+
+// LoadOrStore returns the existing value for key if present
+// Otherwise it stores and returns value. loaded is true if the value was loaded.
+// Average case time-complexity: O(depth)
+func (m *SyncMap) LoadOrStore(key interface{}, value interface{}) (actual interface{}, loaded bool) {
+	tree := m.tree
+	tree.lock()
+	defer tree.mutex.Unlock()
+	if node := tree.findKey(key); node != nil {
+		return node.value.(MapEntry).Value, true
+	}
+	m.add(MapEntry{key, value})
+	return value, false
+}
+
+// LoadAndDelete deletes the value for key, returning the previous value if any
+// loaded reports whether the key was present.
+// Average case time-complexity: O(depth)
+func (m *SyncMap) LoadAndDelete(key interface{}) (value interface{}, loaded bool) {
+	tree := m.tree
+	tree.lock()
+	defer tree.mutex.Unlock()
+	node := tree.findKey(key)
+	if node == nil {
+		return nil, false
+	}
+	entry := node.value.(MapEntry)
+	m.remove(entry)
+	return entry.Value, true
+}
+
+// Delete deletes the value for key
+// Average case time-complexity: O(depth)
+func (m *SyncMap) Delete(key interface{}) {
+	m.LoadAndDelete(key)
+}
+
+// CompareAndSwap swaps the old and new values for key if the value stored is equal to old
+// The stored value has to be comparable with ==.
+// Average case time-complexity: O(depth)
+func (m *SyncMap) CompareAndSwap(key interface{}, old interface{}, new interface{}) (swapped bool) {
+	tree := m.tree
+	tree.lock()
+	defer tree.mutex.Unlock()
+	node := tree.findKey(key)
+	if node == nil || node.value.(MapEntry).Value != old {
+		return false
+	}
+	m.remove(node.value)
+	m.add(MapEntry{key, new})
+	return true
+}
+
+// CompareAndDelete deletes the entry for key if its value is equal to old
+// The stored value has to be comparable with ==.
+// Average case time-complexity: O(depth)
+func (m *SyncMap) CompareAndDelete(key interface{}, old interface{}) (deleted bool) {
+	tree := m.tree
+	tree.lock()
+	defer tree.mutex.Unlock()
+	node := tree.findKey(key)
+	if node == nil || node.value.(MapEntry).Value != old {
+		return false
+	}
+	m.remove(node.value)
+	return true
+}
+
+// Range calls f for each key and value in ascending key order until f returns false
+// Entries modified during the iteration may or may not be visited, as with sync.Map.
+// Time-complexity: O(size)
+func (m *SyncMap) Range(f func(key interface{}, value interface{}) bool) {
+	for it := m.tree.Iterator(); it.Next(); {
+		entry := it.Value().(MapEntry)
+		if !f(entry.Key, entry.Value) {
+			return
+		}
+	}
+}
+
+// Clear deletes all the entries
+// Time-complexity: O(size)
+func (m *SyncMap) Clear() {
+	tree := m.tree
+	tree.lock()
+	defer tree.mutex.Unlock()
+	for _, entry := range tree.values() {
+		m.remove(entry)
+	}
+}
+
+// add inserts an entry whose key is absent, holding the write lock
+func (m *SyncMap) add(entry MapEntry) {
+	value := m.tree.own(entry)
+	if m.tree.insert(value) {
+		m.tree.wal.log(_WALInsert, value)
+		m.tree.hooks.fireInsert(value)
+	}
+}
+
+// remove deletes a stored entry, holding the write lock
+func (m *SyncMap) remove(entry interface{}) {
+	if m.tree.delete(entry) {
+		m.tree.wal.log(_WALDelete, entry)
+		m.tree.hooks.fireDelete(entry)
+	}
+}
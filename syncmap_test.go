@@ -0,0 +1,72 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSyncMap(t *testing.T) {
+	m := NewSyncMap(OrderedSmaller[string], OrderedLarger[string])
+	m.Store("b", 2)
+	if actual, loaded := m.LoadOrStore("a", 1); actual != 1 || loaded {
+		t.Errorf("LoadOrStore(a): {Expected: 1 false | Actual: %v %v}", actual, loaded)
+	}
+	if actual, loaded := m.LoadOrStore("a", 10); actual != 1 || !loaded {
+		t.Errorf("LoadOrStore(a): {Expected: 1 true | Actual: %v %v}", actual, loaded)
+	}
+	if previous, loaded := m.Swap("b", 20); previous != 2 || !loaded {
+		t.Errorf("Swap(b): {Expected: 2 true | Actual: %v %v}", previous, loaded)
+	}
+	if value, ok := m.Load("b"); value != 20 || !ok {
+		t.Errorf("Load(b): {Expected: 20 true | Actual: %v %v}", value, ok)
+	}
+	if m.CompareAndSwap("b", 2, 3) {
+		t.Errorf("CompareAndSwap(b, 2): {Expected: false | Actual: true}")
+	}
+	if !m.CompareAndDelete("b", 20) {
+		t.Errorf("CompareAndDelete(b, 20): {Expected: true | Actual: false}")
+	}
+	if value, ok := m.Load("b"); value != nil || ok {
+		t.Errorf("Load(b): {Expected: <nil> false | Actual: %v %v}", value, ok)
+	}
+	m.Delete("a")
+	if expected := 0; expected != m.Tree().Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, m.Tree().Size())
+	}
+}
+
+func TestSyncMap_RangeModifying(t *testing.T) {
+	m := NewSyncMap(IntSmaller, IntLarger)
+	for i := 0; i < 100; i++ {
+		m.Store(i, i)
+	}
+	visited := 0
+	m.Range(func(key interface{}, value interface{}) bool {
+		visited++
+		m.Delete(key.(int) + 1)
+		return true
+	})
+	if expected := 50; expected != visited {
+		t.Errorf("Visited: {Expected: %d | Actual: %d}", expected, visited)
+	}
+	m.Clear()
+	if expected := 0; expected != m.Tree().Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, m.Tree().Size())
+	}
+}
+
+// Iterate over a concurrent map in key order
+func ExampleSyncMap_Range() {
+	m := NewSyncMap(OrderedSmaller[string], OrderedLarger[string])
+	m.Store("banana", 3)
+	m.Store("apple", 5)
+	m.Store("cherry", 7)
+	m.Range(func(key interface{}, value interface{}) bool {
+		fmt.Println(key, value)
+		return true
+	})
+	// Output:
+	// apple 5
+	// banana 3
+	// cherry 7
+}
@@ -0,0 +1,81 @@
+package bstree
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// WriteText writes the values of the tree to w in sorted order, one line per value
+// format must not return strings containing newlines.
+// Time-complexity: O(size)
+func (tree *Tree) WriteText(w io.Writer, format func(value interface{}) string) error {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	writer := bufio.NewWriter(w)
+	tree.doInOrder(tree.root, func(value interface{}) {
+		writer.WriteString(format(value))
+		writer.WriteByte('\n')
+	})
+	return writer.Flush()
+}
+
+// ReadText inserts a value for every line read from r
+// Lines are passed to parse without their line ending. Reading stops at
+// the first error, which is annotated with the line number. Values
+// inserted before the error remain in the tree.
+// Average case time-complexity: O(lines * depth)
+func (tree *Tree) ReadText(r io.Reader, parse func(line string) (interface{}, error)) error {
+	scanner := bufio.NewScanner(r)
+	for number := 1; scanner.Scan(); number++ {
+		value, err := parse(scanner.Text())
+		if err != nil {
+			return fmt.Errorf("bstree: line %d: %w", number, err)
+		}
+		tree.Insert(value)
+	}
+	return scanner.Err()
+}
+
+// WriteCSV writes the values of the tree to w in sorted order, one CSV record per value
+// Time-complexity: O(size)
+func (tree *Tree) WriteCSV(w io.Writer, format func(value interface{}) []string) error {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	writer := csv.NewWriter(w)
+	var err error
+	tree.doAscend(tree.root, Range{}, func(value interface{}) bool {
+		err = writer.Write(format(value))
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// ReadCSV inserts a value for every CSV record read from r
+// Reading stops at the first error, which is annotated with the line number.
+// Values inserted before the error remain in the tree.
+// Average case time-complexity: O(records * depth)
+func (tree *Tree) ReadCSV(r io.Reader, parse func(record []string) (interface{}, error)) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		value, err := parse(record)
+		if err != nil {
+			line, _ := reader.FieldPos(0)
+			return fmt.Errorf("bstree: line %d: %w", line, err)
+		}
+		tree.Insert(value)
+	}
+}
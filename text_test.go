@@ -0,0 +1,83 @@
+package bstree
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestTree_TextRoundTrip(t *testing.T) {
+	tree := RandomTree(500, 1000)
+	var buffer bytes.Buffer
+	if err := tree.WriteText(&buffer, func(value interface{}) string {
+		return strconv.Itoa(value.(int))
+	}); err != nil {
+		t.Fatalf("WriteText: {Expected: nil | Actual: %v}", err)
+	}
+	loaded := EmptyTree()
+	if err := loaded.ReadText(&buffer, func(line string) (interface{}, error) {
+		return strconv.Atoi(line)
+	}); err != nil {
+		t.Fatalf("ReadText: {Expected: nil | Actual: %v}", err)
+	}
+	if added, removed := tree.Diff(loaded); len(added) != 0 || len(removed) != 0 {
+		t.Errorf("Diff: {Expected: [] [] | Actual: %v %v}", added, removed)
+	}
+}
+
+func TestTree_ReadTextError(t *testing.T) {
+	tree := EmptyTree()
+	err := tree.ReadText(strings.NewReader("1\n2\nthree\n4\n"), func(line string) (interface{}, error) {
+		return strconv.Atoi(line)
+	})
+	if err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("ReadText: {Expected: line 3 error | Actual: %v}", err)
+	}
+	if expected := 2; expected != tree.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+	}
+}
+
+func TestTree_CSVRoundTrip(t *testing.T) {
+	tree := NewComparable()
+	for i := 0; i < 50; i++ {
+		tree.Insert(Version{i % 7, i})
+	}
+	var buffer bytes.Buffer
+	if err := tree.WriteCSV(&buffer, func(value interface{}) []string {
+		version := value.(Version)
+		return []string{strconv.Itoa(version.Major), strconv.Itoa(version.Minor)}
+	}); err != nil {
+		t.Fatalf("WriteCSV: {Expected: nil | Actual: %v}", err)
+	}
+	loaded := NewComparable()
+	if err := loaded.ReadCSV(&buffer, func(record []string) (interface{}, error) {
+		major, err := strconv.Atoi(record[0])
+		if err != nil {
+			return nil, err
+		}
+		minor, err := strconv.Atoi(record[1])
+		return Version{major, minor}, err
+	}); err != nil {
+		t.Fatalf("ReadCSV: {Expected: nil | Actual: %v}", err)
+	}
+	if added, removed := tree.Diff(loaded); len(added) != 0 || len(removed) != 0 {
+		t.Errorf("Diff: {Expected: [] [] | Actual: %v %v}", added, removed)
+	}
+}
+
+// Dump a tree as CSV
+func ExampleTree_WriteCSV() {
+	tree := Ordered[string]()
+	tree.Insert("b,c")
+	tree.Insert("a")
+	tree.WriteCSV(os.Stdout, func(value interface{}) []string {
+		return []string{value.(string), fmt.Sprint(len(value.(string)))}
+	})
+	// Output:
+	// a,1
+	// "b,c",3
+}
@@ -0,0 +1,34 @@
+package bstree
+
+// WithThreads turns the missing right children of the tree into threads
+// A node without a right child links to its in-order successor instead, as
+// in a classic right-threaded tree, so in-order traversals and iterators
+// walk the threads instead of recursing or keeping a stack and need no
+// allocations at all, which matters for tight, allocation-sensitive loops.
+// The threads reuse the right links, so the nodes don't grow. Keeping the
+// threads up to date costs O(1) per changed link.
+func WithThreads() Option {
+	return func(tree *Tree) {
+		tree.threads = true
+	}
+}
+
+// nextInOrder returns the node holding the next value in order, or nil
+// It needs threads.
+// Time-complexity: O(1) amortized over a full traversal
+func nextInOrder(node *_Node) *_Node {
+	if node.thread || node.link == nil {
+		return node.link
+	}
+	return leftmost(node.link)
+}
+
+// doThreadedInOrder visits the values in order by following the threads
+func (tree *Tree) doThreadedInOrder(visitor Visitor) {
+	if tree.root == nil {
+		return
+	}
+	for node := leftmost(tree.root); node != nil; node = nextInOrder(node) {
+		visitor(node.value)
+	}
+}
@@ -0,0 +1,84 @@
+package bstree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestWithThreads(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend, WithThreads(), WithAllocator(NewSlabAllocator(64)))
+		for _, value := range rand.Perm(1000) {
+			tree.Insert(value)
+		}
+		for i := 0; i < 700; i++ {
+			tree.Delete(rand.Intn(1000))
+		}
+		mustCheck(t, tree)
+		var expected []interface{}
+		tree.mutex.RLock()
+		tree.doInOrder(tree.root, func(value interface{}) {
+			expected = append(expected, value)
+		})
+		tree.mutex.RUnlock()
+		if actual := Values(tree); fmt.Sprint(expected) != fmt.Sprint(actual) {
+			t.Errorf("Traverse: {Expected: %v | Actual: %v}", expected, actual)
+		}
+		tree.DeleteRange(Range{GTE: 100, LT: 900})
+		mustCheck(t, tree)
+		for tree.Size() > 0 {
+			tree.Delete(tree.Minimum())
+		}
+		tree.Insert(5)
+		mustCheck(t, tree)
+	})
+}
+
+func TestWithThreads_Allocations(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithThreads())
+	for _, value := range rand.Perm(1000) {
+		tree.Insert(value)
+	}
+	sum := 0
+	visitor := func(value interface{}) {
+		sum += value.(int)
+	}
+	if allocs := testing.AllocsPerRun(10, func() { tree.Traverse(InOrder, visitor) }); allocs != 0 {
+		t.Errorf("Allocations per traversal: {Expected: 0 | Actual: %v}", allocs)
+	}
+}
+
+// Traverse a threaded tree in order
+func ExampleWithThreads() {
+	tree := New(IntSmaller, IntLarger, WithThreads())
+	for _, value := range []int{4, 2, 6, 1, 3, 5, 7} {
+		tree.Insert(value)
+	}
+	tree.Delete(4)
+	tree.Traverse(InOrder, func(value interface{}) {
+		fmt.Print(value, ",")
+	})
+	fmt.Println()
+	// Output:
+	// 1,2,3,5,6,7,
+}
+
+func TestWithThreads_RandomOperations(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend, WithThreads(), WithDuplicates(), WithParentPointers())
+		for i := 0; i < 2000; i++ {
+			if value := rand.Intn(50); rand.Intn(2) == 0 {
+				tree.Delete(value)
+			} else {
+				tree.Insert(value)
+			}
+			mustCheck(t, tree)
+		}
+		inverted := tree.Invert()
+		mustCheck(t, inverted)
+		if subtree, ok := tree.Subtree(tree.Minimum()); ok {
+			mustCheck(t, subtree)
+		}
+	})
+}
@@ -0,0 +1,67 @@
+package bstree
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrLockTimeout matches the LockTimeoutError of an operation that gave up waiting for the lock
+var ErrLockTimeout = errors.New("bstree: timed out waiting for the lock")
+
+// LockTimeoutError reports an operation that gave up waiting for the lock
+type LockTimeoutError struct {
+	Op      string        // the abandoned operation, e.g. "Insert"
+	Timeout time.Duration // the timeout of the tree
+}
+
+func (err *LockTimeoutError) Error() string {
+	return fmt.Sprintf("bstree: %s timed out after %v waiting for the lock", err.Op, err.Timeout)
+}
+
+// Is makes errors.Is(err, ErrLockTimeout) hold
+func (err *LockTimeoutError) Is(target error) bool {
+	return target == ErrLockTimeout
+}
+
+// maxLockBackoff limits the pause between two attempts to take a busy lock
+const maxLockBackoff = time.Millisecond
+
+// WithOpTimeout makes the methods that modify the tree, and Exists, give up if they can't take the lock within d
+// It protects services from lock convoys behind slow visitors. Abandoned
+// operations leave the tree unchanged and report it in their results:
+// Insert, Delete, Exists, GetOrInsert and InsertIf return false and
+// DeleteRange returns 0, while InsertE, DeleteE, ExistsE, Map, UpdateWhere
+// and ReplayWAL return a *LockTimeoutError matching ErrLockTimeout. A
+// waiting operation polls the lock with exponential backoff up to 1ms, so
+// it doesn't queue like a blocked one and may lose the lock to later
+// callers. The other read-only methods and the methods of SyncMap have no
+// way to report giving up, so they wait as usual.
+func WithOpTimeout(d time.Duration) Option {
+	return func(tree *Tree) {
+		tree.opTimeout = d
+	}
+}
+
+// lockOp takes the write lock for op and merges pending inserts like lock
+// Trees created WithOpTimeout wait only for a limited time.
+func (tree *Tree) lockOp(op string) error {
+	if err := tree.acquire(op, true); err != nil {
+		return err
+	}
+	tree.flush()
+	return nil
+}
+
+// await calls try until it takes the lock for op, or the timeout of the tree elapses
+func (tree *Tree) await(op string, try func() bool) error {
+	deadline := time.Now().Add(tree.opTimeout)
+	for backoff := time.Microsecond; !try(); backoff = min(2*backoff, maxLockBackoff) {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return &LockTimeoutError{Op: op, Timeout: tree.opTimeout}
+		}
+		time.Sleep(min(backoff, remaining))
+	}
+	return nil
+}
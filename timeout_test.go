@@ -0,0 +1,97 @@
+package bstree
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWithOpTimeout(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithOpTimeout(10*time.Millisecond))
+	tree.Insert(1)
+	tree.mutex.Lock()
+	start := time.Now()
+	err := tree.InsertE(2)
+	var timeout *LockTimeoutError
+	if !errors.Is(err, ErrLockTimeout) || !errors.As(err, &timeout) || timeout.Op != "Insert" {
+		t.Errorf("InsertE(2): {Expected: Insert %v | Actual: %v}", ErrLockTimeout, err)
+	}
+	if waited := time.Since(start); waited < 10*time.Millisecond {
+		t.Errorf("Waited: {Expected: >= 10ms | Actual: %v}", waited)
+	}
+	if _, err := tree.ExistsE(1); !errors.Is(err, ErrLockTimeout) {
+		t.Errorf("ExistsE(1): {Expected: %v | Actual: %v}", ErrLockTimeout, err)
+	}
+	if tree.Delete(1) {
+		t.Errorf("Delete(1): {Expected: false | Actual: true}")
+	}
+	tree.mutex.Unlock()
+	if expected := 1; expected != tree.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+	}
+}
+
+func TestWithOpTimeout_Released(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithOpTimeout(time.Second))
+	tree.mutex.Lock()
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		tree.mutex.Unlock()
+	}()
+	if err := tree.InsertE(1); err != nil {
+		t.Errorf("InsertE(1): {Expected: <nil> | Actual: %v}", err)
+	}
+	if !tree.Exists(1) {
+		t.Errorf("Exists(1): {Expected: true | Actual: false}")
+	}
+}
+
+func TestWithOpTimeout_Modifications(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithOpTimeout(time.Millisecond))
+	tree.Insert(1)
+	var log bytes.Buffer
+	New(IntSmaller, IntLarger, WithWAL(&log)).Insert(2)
+	tree.mutex.Lock()
+	if _, inserted := tree.GetOrInsert(2); inserted {
+		t.Errorf("GetOrInsert(2): {Expected: false | Actual: true}")
+	}
+	if tree.InsertIf(2, func(view ReadView) bool { return true }) {
+		t.Errorf("InsertIf(2): {Expected: false | Actual: true}")
+	}
+	if deleted := tree.DeleteRange(Range{}); deleted != 0 {
+		t.Errorf("DeleteRange: {Expected: 0 | Actual: %d}", deleted)
+	}
+	for op, err := range map[string]error{
+		"Map": tree.Map(func(value interface{}) interface{} { return value.(int) + 1 }),
+		"UpdateWhere": func() error {
+			_, err := tree.UpdateWhere(func(value interface{}) bool { return true }, func(value interface{}) interface{} { return value })
+			return err
+		}(),
+		"ReplayWAL": tree.ReplayWAL(&log),
+	} {
+		var timeout *LockTimeoutError
+		if !errors.As(err, &timeout) || timeout.Op != op {
+			t.Errorf("%s: {Expected: %s %v | Actual: %v}", op, op, ErrLockTimeout, err)
+		}
+	}
+	tree.mutex.Unlock()
+	if expected, actual := []interface{}{1}, Values(tree); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Values: {Expected: %v | Actual: %v}", expected, actual)
+	}
+}
+
+// Give up on an insert blocked by a long traversal
+func ExampleWithOpTimeout() {
+	tree := New(IntSmaller, IntLarger, WithOpTimeout(time.Millisecond))
+	tree.Insert(1)
+	tree.Traverse(InOrder, func(value interface{}) {
+		fmt.Println(tree.InsertE(2))
+	})
+	fmt.Println(tree.InsertE(2))
+	// Output:
+	// bstree: Insert timed out after 1ms waiting for the lock
+	// <nil>
+}
@@ -0,0 +1,57 @@
+package bstree
+
+import "errors"
+
+// ErrWouldBlock is returned by TryInsert and TryExists when another goroutine holds the lock
+var ErrWouldBlock = errors.New("bstree: lock is held by another goroutine")
+
+// TryInsert is like Insert but fails with ErrWouldBlock instead of waiting for the lock
+// It suits latency-sensitive callers that rather retry or degrade than
+// queue behind a long traversal. A busy lock is not the only reason it
+// can fail: it competes with readers and other writers without priority.
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (tree *Tree) TryInsert(value interface{}) (bool, error) {
+	defer tree.recoverPanic()
+	return tree.insertValue(value, false)
+}
+
+// TryExists is like Exists but fails with ErrWouldBlock instead of waiting for the lock
+// It only fails while a writer holds the lock, or any goroutine for splay
+// trees, whose lookups need the write lock.
+// Average case time-complexity: O(depth)
+// Worst case time-complexity: O(size)
+func (tree *Tree) TryExists(value interface{}) (bool, error) {
+	defer tree.recoverPanic()
+	return tree.exists(value, false)
+}
+
+// acquire takes the write lock for op, failing with ErrWouldBlock if it is busy unless wait is set
+// Trees created WithOpTimeout wait only for a limited time.
+func (tree *Tree) acquire(op string, wait bool) error {
+	switch {
+	case wait && tree.opTimeout == 0:
+		tree.writeLock()
+		return nil
+	case tree.mutex.TryLock():
+		return nil
+	case !wait:
+		return ErrWouldBlock
+	}
+	return tree.await(op, tree.mutex.TryLock)
+}
+
+// acquireRead takes the read lock for op, failing with ErrWouldBlock if it is busy unless wait is set
+// Trees created WithOpTimeout wait only for a limited time.
+func (tree *Tree) acquireRead(op string, wait bool) error {
+	switch {
+	case wait && tree.opTimeout == 0:
+		tree.readLock()
+		return nil
+	case tree.mutex.TryRLock():
+		return nil
+	case !wait:
+		return ErrWouldBlock
+	}
+	return tree.await(op, tree.mutex.TryRLock)
+}
@@ -0,0 +1,55 @@
+package bstree
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestTree_TryInsert(t *testing.T) {
+	tree := CompleteTree(10)
+	tree.View(func(view ReadView) {
+		if inserted, err := tree.TryInsert(11); inserted || !errors.Is(err, ErrWouldBlock) {
+			t.Errorf("TryInsert(11) while reading: {Expected: false %v | Actual: %t %v}", ErrWouldBlock, inserted, err)
+		}
+		if found, err := tree.TryExists(5); !found || err != nil {
+			t.Errorf("TryExists(5) while reading: {Expected: true <nil> | Actual: %t %v}", found, err)
+		}
+	})
+	if inserted, err := tree.TryInsert(11); !inserted || err != nil {
+		t.Errorf("TryInsert(11): {Expected: true <nil> | Actual: %t %v}", inserted, err)
+	}
+	if inserted, err := tree.TryInsert(11); inserted || err != nil {
+		t.Errorf("TryInsert(11) again: {Expected: false <nil> | Actual: %t %v}", inserted, err)
+	}
+}
+
+func TestTree_TryExists(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, backend Option) {
+		tree := New(IntSmaller, IntLarger, backend)
+		tree.Insert(1)
+		tree.mutex.Lock()
+		found, err := tree.TryExists(1)
+		tree.mutex.Unlock()
+		if found || !errors.Is(err, ErrWouldBlock) {
+			t.Errorf("TryExists(1) while writing: {Expected: false %v | Actual: %t %v}", ErrWouldBlock, found, err)
+		}
+		if found, err := tree.TryExists(1); !found || err != nil {
+			t.Errorf("TryExists(1): {Expected: true <nil> | Actual: %t %v}", found, err)
+		}
+	})
+}
+
+// Fail fast while a long traversal holds the lock
+func ExampleTree_TryInsert() {
+	tree := CompleteTree(3)
+	tree.Traverse(InOrder, func(value interface{}) {
+		if value == 2 {
+			fmt.Println(tree.TryInsert(4))
+		}
+	})
+	fmt.Println(tree.TryInsert(4))
+	// Output:
+	// false bstree: lock is held by another goroutine
+	// true <nil>
+}
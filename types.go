@@ -0,0 +1,39 @@
+package bstree
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrTypeMismatch is returned for values a tree created with WithType does not accept
+var ErrTypeMismatch = errors.New("bstree: value has the wrong type")
+
+// WithType restricts the values of the tree to the dynamic type valueType
+// Values of other types are rejected before they reach the comparators:
+// Insert, Exists and Delete return false for them and the E variants
+// return ErrTypeMismatch. If valueType is an interface type, values
+// implementing it are accepted.
+func WithType(valueType reflect.Type) Option {
+	return func(tree *Tree) {
+		tree.valueType = valueType
+	}
+}
+
+// checkType returns an error if value is not accepted by the tree
+func (tree *Tree) checkType(value interface{}) error {
+	if accepted, err := tree.checkNil(value); accepted || err != nil {
+		return err
+	}
+	if tree.valueType == nil {
+		return nil
+	}
+	actual := reflect.TypeOf(value)
+	if actual == tree.valueType {
+		return nil
+	}
+	if actual != nil && tree.valueType.Kind() == reflect.Interface && actual.Implements(tree.valueType) {
+		return nil
+	}
+	return fmt.Errorf("%w: expected %v, got %v", ErrTypeMismatch, tree.valueType, actual)
+}
@@ -0,0 +1,54 @@
+package bstree
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestTree_WithType(t *testing.T) {
+	tree := New(IntSmaller, IntLarger, WithType(reflect.TypeOf(0)))
+	tree.Insert(1)
+	for _, value := range []interface{}{"1", 1.0, int64(1), nil} {
+		if tree.Insert(value) {
+			t.Errorf("Insert(%#v): {Expected: false | Actual: true}", value)
+		}
+		if tree.Exists(value) {
+			t.Errorf("Exists(%#v): {Expected: false | Actual: true}", value)
+		}
+		if tree.Delete(value) {
+			t.Errorf("Delete(%#v): {Expected: false | Actual: true}", value)
+		}
+		expected := ErrTypeMismatch
+		if value == nil {
+			expected = ErrNilValue
+		}
+		if err := tree.InsertE(value); !errors.Is(err, expected) {
+			t.Errorf("InsertE(%#v): {Expected: %v | Actual: %v}", value, expected, err)
+		}
+	}
+	if expected := 1; expected != tree.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+	}
+}
+
+func TestTree_WithInterfaceType(t *testing.T) {
+	tree := New(ComparableSmaller, ComparableLarger, WithType(reflect.TypeOf((*Comparable)(nil)).Elem()))
+	if !tree.Insert(Version{1, 0}) {
+		t.Errorf("Insert(Version): {Expected: true | Actual: false}")
+	}
+	if tree.Insert(1) {
+		t.Errorf("Insert(1): {Expected: false | Actual: true}")
+	}
+}
+
+// Reject values of the wrong type with an error
+func ExampleWithType() {
+	tree := Ordered[string]()
+	fmt.Println(tree.InsertE("one"))
+	fmt.Println(tree.InsertE(2))
+	// Output:
+	// <nil>
+	// bstree: value has the wrong type: expected string, got int
+}
@@ -0,0 +1,33 @@
+package bstree
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnordered is returned when the layout of a tree contradicts a comparator
+var ErrUnordered = errors.New("bstree: values out of order")
+
+// ValidateWith checks whether the layout of the tree is consistent with smaller and larger
+// It detects data that was loaded under one ordering, e.g. a collation,
+// and is now queried under another. The in-order sequence of the values
+// has to be ascending under the given comparators, strictly unless the
+// tree has duplicates. The first pair of values out of order is reported
+// in an error wrapping ErrUnordered.
+// Time-complexity: O(size)
+func (tree *Tree) ValidateWith(smaller Smaller, larger Larger) error {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	var err error
+	var previous interface{}
+	first := true
+	tree.doAscend(tree.root, Range{}, func(value interface{}) bool {
+		if !first && (larger(previous, value) || (!tree.duplicates && !smaller(previous, value))) {
+			err = fmt.Errorf("%w: %s is not before %s", ErrUnordered, tree.format(previous), tree.format(value))
+			return false
+		}
+		previous, first = value, false
+		return true
+	})
+	return err
+}
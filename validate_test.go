@@ -0,0 +1,41 @@
+package bstree
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestTree_ValidateWith(t *testing.T) {
+	tree := New(OrderedSmaller[string], OrderedLarger[string])
+	for _, value := range []string{"b", "A", "c", "D"} {
+		tree.Insert(value)
+	}
+	if err := tree.ValidateWith(OrderedSmaller[string], OrderedLarger[string]); err != nil {
+		t.Errorf("ValidateWith(same order): {Expected: <nil> | Actual: %v}", err)
+	}
+	if err := tree.ValidateWith(StringFoldSmaller, StringFoldLarger); !errors.Is(err, ErrUnordered) {
+		t.Errorf("ValidateWith(case folding): {Expected: %v | Actual: %v}", ErrUnordered, err)
+	}
+	// Equal values under the other ordering are only allowed with duplicates
+	folded := New(OrderedSmaller[string], OrderedLarger[string])
+	folded.Insert("a")
+	folded.Insert("A")
+	if err := folded.ValidateWith(StringFoldSmaller, StringFoldLarger); !errors.Is(err, ErrUnordered) {
+		t.Errorf("ValidateWith(equal values): {Expected: %v | Actual: %v}", ErrUnordered, err)
+	}
+	if err := EmptyTree().ValidateWith(IntLarger, IntSmaller); err != nil {
+		t.Errorf("ValidateWith on empty tree: {Expected: <nil> | Actual: %v}", err)
+	}
+}
+
+// Detect a tree loaded under a different collation
+func ExampleTree_ValidateWith() {
+	tree := New(OrderedSmaller[string], OrderedLarger[string])
+	for _, value := range []string{"apple", "Banana", "cherry"} {
+		tree.Insert(value)
+	}
+	fmt.Println(tree.ValidateWith(StringFoldSmaller, StringFoldLarger))
+	// Output:
+	// bstree: values out of order: Banana is not before apple
+}
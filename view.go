@@ -0,0 +1,161 @@
+package bstree
+
+// ReadView gives read access to a tree whose lock is already held
+// It is only valid during the callback it was passed to and must not be
+// retained or used from other goroutines.
+type ReadView interface {
+	// Exists checks if a value exists in the tree
+	Exists(value interface{}) bool
+	// Get returns the stored value equal to value
+	Get(value interface{}) (interface{}, bool)
+	// Floor returns the largest value smaller than or equal to value
+	Floor(value interface{}) (interface{}, bool)
+	// Ceiling returns the smallest value larger than or equal to value
+	Ceiling(value interface{}) (interface{}, bool)
+	// Rank returns the number of values smaller than value
+	Rank(value interface{}) int
+	// Size returns the size of the tree
+	Size() int
+	// Minimum returns the smallest value in the tree
+	Minimum() interface{}
+	// Maximum returns the largest value in the tree
+	Maximum() interface{}
+	// CountRange returns the number of values within r
+	CountRange(r Range) int
+	// Root returns the root node of the tree, which is nil if the tree is empty
+	Root() Node
+}
+
+// _ReadView implements ReadView on a locked tree
+type _ReadView struct {
+	tree *Tree
+}
+
+func (view _ReadView) Exists(value interface{}) bool {
+	return view.tree.checkType(value) == nil && view.tree.find(value) != nil
+}
+
+func (view _ReadView) Get(value interface{}) (interface{}, bool) {
+	if view.tree.checkType(value) != nil {
+		return nil, false
+	}
+	return valueOf(view.tree.find(value))
+}
+
+func (view _ReadView) Floor(value interface{}) (interface{}, bool) {
+	if view.tree.checkType(value) != nil {
+		return nil, false
+	}
+	return valueOf(view.tree.floor(value))
+}
+
+func (view _ReadView) Ceiling(value interface{}) (interface{}, bool) {
+	if view.tree.checkType(value) != nil {
+		return nil, false
+	}
+	return valueOf(view.tree.ceiling(value))
+}
+
+func (view _ReadView) Rank(value interface{}) int {
+	if view.tree.checkType(value) != nil {
+		return 0
+	}
+	return view.tree.countBelow(func(stored interface{}) bool {
+		return view.tree.smaller(stored, value)
+	})
+}
+
+func (view _ReadView) Size() int {
+	return view.tree.size
+}
+
+func (view _ReadView) Minimum() interface{} {
+	return view.tree.minimum
+}
+
+func (view _ReadView) Maximum() interface{} {
+	return view.tree.maximum
+}
+
+func (view _ReadView) CountRange(r Range) int {
+	return view.tree.countRange(r)
+}
+
+func (view _ReadView) Root() Node {
+	return Node{view.tree.root}
+}
+
+// valueOf returns the value of node, reporting whether there is one
+func valueOf(node *_Node) (interface{}, bool) {
+	if node == nil {
+		return nil, false
+	}
+	return node.value, true
+}
+
+// floor returns the node holding the largest value smaller than or equal to value, or nil
+func (tree *Tree) floor(value interface{}) *_Node {
+	var floor *_Node
+	node := tree.root
+	for node != nil {
+		if tree.smaller(value, node.value) {
+			node = node.left
+		} else {
+			floor = node
+			node = node.right()
+		}
+	}
+	return floor
+}
+
+// ceiling returns the node holding the smallest value larger than or equal to value, or nil
+func (tree *Tree) ceiling(value interface{}) *_Node {
+	var ceiling *_Node
+	node := tree.root
+	for node != nil {
+		if tree.larger(value, node.value) {
+			node = node.right()
+		} else {
+			ceiling = node
+			node = node.left
+		}
+	}
+	return ceiling
+}
+
+// View calls f with a view of the tree under a single read lock
+// All reads through the view observe the same state of the tree, which
+// makes it possible to combine several queries consistently. f must not
+// call methods of the tree itself; it has to use the view instead.
+// Time-complexity: O(1), plus the cost of f
+func (tree *Tree) View(f func(view ReadView)) {
+	defer tree.recoverPanic()
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	f(_ReadView{tree})
+}
+
+// InsertIf inserts value if it doesn't exist yet and cond returns true
+// cond is evaluated under the write lock, so the tree cannot change
+// between checking the condition and inserting, e.g. to insert only while
+// the tree holds fewer than N values. cond must not call methods of the
+// tree itself; it has to use the view instead.
+// Average case time-complexity: O(depth), plus the cost of cond
+// Worst case time-complexity: O(size), plus the cost of cond
+func (tree *Tree) InsertIf(value interface{}, cond func(view ReadView) bool) bool {
+	defer tree.recoverPanic()
+	if tree.checkType(value) != nil {
+		return false
+	}
+	value = tree.own(value)
+	if tree.lockOp("InsertIf") != nil {
+		return false
+	}
+	defer tree.mutex.Unlock()
+	if !cond(_ReadView{tree}) || !tree.insert(value) {
+		return false
+	}
+	tree.wal.log(_WALInsert, value)
+	tree.hooks.fireInsert(value)
+	return true
+}
@@ -0,0 +1,97 @@
+package bstree
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestTree_InsertIf(t *testing.T) {
+	tree := EmptyTree()
+	var wg sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				tree.InsertIf(worker*100+i, func(view ReadView) bool {
+					return view.Size() < 50
+				})
+			}
+		}(worker)
+	}
+	wg.Wait()
+	if expected := 50; expected != tree.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", expected, tree.Size())
+	}
+	if tree.InsertIf(1000, func(view ReadView) bool { return true }) != true {
+		t.Errorf("InsertIf(1000, true): {Expected: true | Actual: false}")
+	}
+	if tree.InsertIf(1000, func(view ReadView) bool { return true }) != false {
+		t.Errorf("InsertIf(1000, true) again: {Expected: false | Actual: true}")
+	}
+}
+
+func TestTree_View(t *testing.T) {
+	tree := EmptyTree()
+	for i := 0; i < 100; i += 10 {
+		tree.Insert(i)
+	}
+	tests := []struct {
+		value          int
+		floor, ceiling interface{}
+		rank           int
+		exists         bool
+	}{
+		{-5, nil, 0, 0, false},
+		{0, 0, 0, 0, true},
+		{15, 10, 20, 2, false},
+		{90, 90, 90, 9, true},
+		{95, 90, nil, 10, false},
+	}
+	tree.View(func(view ReadView) {
+		for _, test := range tests {
+			floor, _ := view.Floor(test.value)
+			ceiling, _ := view.Ceiling(test.value)
+			_, exists := view.Get(test.value)
+			if floor != test.floor || ceiling != test.ceiling || view.Rank(test.value) != test.rank || exists != test.exists {
+				t.Errorf("View(%d): {Expected: %v %v %d %t | Actual: %v %v %d %t}", test.value,
+					test.floor, test.ceiling, test.rank, test.exists, floor, ceiling, view.Rank(test.value), exists)
+			}
+		}
+		if view.Minimum() != 0 || view.Maximum() != 90 || view.Size() != 10 {
+			t.Errorf("View: {Expected: 0 90 10 | Actual: %v %v %d}", view.Minimum(), view.Maximum(), view.Size())
+		}
+	})
+}
+
+// Read the neighbours of a value consistently
+func ExampleTree_View() {
+	tree := EmptyTree()
+	for _, value := range []int{10, 20, 30} {
+		tree.Insert(value)
+	}
+	tree.View(func(view ReadView) {
+		below, _ := view.Floor(25)
+		above, _ := view.Ceiling(25)
+		fmt.Println(below, above, view.Rank(25))
+	})
+	// Output:
+	// 20 30 2
+}
+
+// Insert a reservation only if it doesn't overlap another one
+func ExampleTree_InsertIf() {
+	tree := EmptyTree()
+	tree.Insert(10)
+	free := func(start int) func(view ReadView) bool {
+		return func(view ReadView) bool {
+			return view.CountRange(Range{GT: start - 5, LT: start + 5}) == 0
+		}
+	}
+	fmt.Println(tree.InsertIf(12, free(12)))
+	fmt.Println(tree.InsertIf(20, free(20)))
+	// Output:
+	// false
+	// true
+}
@@ -0,0 +1,91 @@
+package bstree
+
+import (
+	"encoding/gob"
+	"io"
+)
+
+// _WALOp identifies the operation recorded in a write-ahead log entry
+type _WALOp byte
+
+const (
+	_ _WALOp = iota
+	_WALInsert
+	_WALDelete
+)
+
+// _WALRecord is a single entry in the write-ahead log
+type _WALRecord struct {
+	Op    _WALOp
+	Value interface{}
+}
+
+// _WAL appends successful mutations of a tree to an io.Writer
+type _WAL struct {
+	encoder *gob.Encoder
+	err     error
+}
+
+// WithWAL makes the tree record every successful Insert and Delete to w
+// The log can later be fed to ReplayWAL to reconstruct the tree.
+// Values are encoded using encoding/gob, so custom value types
+// have to be registered using gob.Register before they are logged.
+func WithWAL(w io.Writer) Option {
+	return func(tree *Tree) {
+		tree.wal = &_WAL{encoder: gob.NewEncoder(w)}
+	}
+}
+
+// log writes a record to the log. Once a write fails the log stops
+// recording and the error is reported by WALError.
+func (wal *_WAL) log(op _WALOp, value interface{}) {
+	if wal == nil || wal.err != nil {
+		return
+	}
+	wal.err = wal.encoder.Encode(&_WALRecord{Op: op, Value: value})
+}
+
+// WALError returns the first error encountered while writing the write-ahead log
+// Time-complexity: O(1)
+func (tree *Tree) WALError() error {
+	tree.mutex.RLock()
+	defer tree.mutex.RUnlock()
+	if tree.wal == nil {
+		return nil
+	}
+	return tree.wal.err
+}
+
+// ReplayWAL applies the operations recorded in a write-ahead log to the tree
+// Replayed operations are not written to the tree's own log,
+// but they are reported to OnInsert and OnDelete hooks.
+// A log that ends in the middle of a record, as left behind by a crash,
+// results in io.ErrUnexpectedEOF after all complete records have been applied.
+// Time-complexity: O(records * depth)
+func (tree *Tree) ReplayWAL(r io.Reader) error {
+	if err := tree.lockOp("ReplayWAL"); err != nil {
+		return err
+	}
+	defer tree.mutex.Unlock()
+	decoder := gob.NewDecoder(r)
+	for {
+		var record _WALRecord
+		if err := decoder.Decode(&record); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		switch record.Op {
+		case _WALInsert:
+			value := tree.own(record.Value)
+			if tree.insert(value) {
+				tree.hooks.fireInsert(value)
+			}
+		case _WALDelete:
+			if tree.delete(record.Value) {
+				tree.hooks.fireDelete(record.Value)
+			}
+		}
+	}
+}
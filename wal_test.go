@@ -0,0 +1,51 @@
+package bstree
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestTree_ReplayWAL(t *testing.T) {
+	var log bytes.Buffer
+	tree := New(IntSmaller, IntLarger, WithWAL(&log))
+	for i := 0; i < 100; i++ {
+		tree.Insert(i)
+	}
+	tree.Insert(50)
+	for i := 0; i < 100; i += 3 {
+		tree.Delete(i)
+	}
+	if err := tree.WALError(); err != nil {
+		t.Fatalf("WALError: {Expected: nil | Actual: %v}", err)
+	}
+
+	replayed := EmptyTree()
+	if err := replayed.ReplayWAL(bytes.NewReader(log.Bytes())); err != nil {
+		t.Fatalf("ReplayWAL: {Expected: nil | Actual: %v}", err)
+	}
+	if tree.Size() != replayed.Size() {
+		t.Errorf("Size: {Expected: %d | Actual: %d}", tree.Size(), replayed.Size())
+	}
+	for i := 0; i < 100; i++ {
+		if tree.Exists(i) != replayed.Exists(i) {
+			t.Errorf("Exists(%d): {Expected: %t | Actual: %t}", i, tree.Exists(i), replayed.Exists(i))
+		}
+	}
+}
+
+func TestTree_ReplayWALTruncated(t *testing.T) {
+	var log bytes.Buffer
+	tree := New(IntSmaller, IntLarger, WithWAL(&log))
+	tree.Insert(1)
+	tree.Insert(2)
+
+	replayed := EmptyTree()
+	err := replayed.ReplayWAL(bytes.NewReader(log.Bytes()[:log.Len()-1]))
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("ReplayWAL: {Expected: %v | Actual: %v}", io.ErrUnexpectedEOF, err)
+	}
+	if !replayed.Exists(1) {
+		t.Errorf("Exists(1): {Expected: true | Actual: false}")
+	}
+}
@@ -0,0 +1,31 @@
+package bstree
+
+// CountWhere returns the number of values for which pred returns true
+// Time-complexity: O(size)
+func (tree *Tree) CountWhere(pred func(value interface{}) bool) int {
+	defer tree.recoverPanic()
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	count := 0
+	tree.doInOrder(tree.root, func(value interface{}) {
+		if pred(value) {
+			count++
+		}
+	})
+	return count
+}
+
+// Any checks if pred returns true for any value
+// The values are tried in sorted order, stopping at the first match.
+// Time-complexity: O(size)
+func (tree *Tree) Any(pred func(value interface{}) bool) bool {
+	defer tree.recoverPanic()
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	found := false
+	tree.doAscend(tree.root, Range{}, func(value interface{}) bool {
+		found = pred(value)
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,43 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_CountWhere(t *testing.T) {
+	tree := CompleteTree(100)
+	even := func(value interface{}) bool { return value.(int)%2 == 0 }
+	if expected, actual := 50, tree.CountWhere(even); expected != actual {
+		t.Errorf("CountWhere: {Expected: %d | Actual: %d}", expected, actual)
+	}
+	if expected, actual := 0, EmptyTree().CountWhere(even); expected != actual {
+		t.Errorf("CountWhere on empty tree: {Expected: %d | Actual: %d}", expected, actual)
+	}
+}
+
+func TestTree_Any(t *testing.T) {
+	tree := CompleteTree(100)
+	calls := 0
+	found := tree.Any(func(value interface{}) bool {
+		calls++
+		return value.(int) >= 10
+	})
+	if !found || calls != 10 {
+		t.Errorf("Any: {Expected: true after 10 calls | Actual: %t after %d calls}", found, calls)
+	}
+	if tree.Any(func(value interface{}) bool { return value.(int) > 100 }) {
+		t.Errorf("Any(> 100): {Expected: false | Actual: true}")
+	}
+}
+
+// Count and look for values matching a predicate
+func ExampleTree_CountWhere() {
+	tree := CompleteTree(10)
+	odd := func(value interface{}) bool { return value.(int)%2 == 1 }
+	fmt.Println(tree.CountWhere(odd))
+	fmt.Println(tree.Any(func(value interface{}) bool { return value.(int) > 9 }))
+	// Output:
+	// 5
+	// true
+}
@@ -0,0 +1,30 @@
+package bstree
+
+// ExistsWithin checks if a value exists in the tree, visiting at most maxNodes nodes
+// found reports whether the value was found. exhausted reports that the
+// budget ran out before the search could finish, in which case found is
+// false and the answer is unknown. This bounds the latency of lookups in
+// unbalanced trees that may have degenerated into long chains.
+// Time-complexity: O(min(maxNodes, depth))
+func (tree *Tree) ExistsWithin(value interface{}, maxNodes int) (found bool, exhausted bool) {
+	if tree.checkType(value) != nil {
+		return false, false
+	}
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	node := tree.root
+	for visited := 0; node != nil; visited++ {
+		if visited == maxNodes {
+			return false, true
+		}
+		switch {
+		case tree.smaller(value, node.value):
+			node = node.left
+		case tree.larger(value, node.value):
+			node = node.right()
+		default:
+			return true, false
+		}
+	}
+	return false, false
+}
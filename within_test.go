@@ -0,0 +1,50 @@
+package bstree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTree_ExistsWithin(t *testing.T) {
+	tree := EmptyTree()
+	for i := 0; i < 100; i++ {
+		tree.Insert(i)
+	}
+	tests := []struct {
+		value     int
+		maxNodes  int
+		found     bool
+		exhausted bool
+	}{
+		{0, 1, true, false},
+		{9, 10, true, false},
+		{10, 10, false, true},
+		{99, 100, true, false},
+		{100, 99, false, true},
+		{100, 100, false, false},
+		{-1, 1, false, false},
+		{0, 0, false, true},
+	}
+	for _, test := range tests {
+		found, exhausted := tree.ExistsWithin(test.value, test.maxNodes)
+		if found != test.found || exhausted != test.exhausted {
+			t.Errorf("ExistsWithin(%d, %d): {Expected: %t %t | Actual: %t %t}", test.value, test.maxNodes, test.found, test.exhausted, found, exhausted)
+		}
+	}
+	if found, exhausted := EmptyTree().ExistsWithin(1, 0); found || exhausted {
+		t.Errorf("Empty: {Expected: false false | Actual: %t %t}", found, exhausted)
+	}
+}
+
+// Give up on lookups that would walk a long chain
+func ExampleTree_ExistsWithin() {
+	tree := EmptyTree()
+	for i := 0; i < 1000; i++ {
+		tree.Insert(i)
+	}
+	fmt.Println(tree.ExistsWithin(5, 64))
+	fmt.Println(tree.ExistsWithin(500, 64))
+	// Output:
+	// true false
+	// false true
+}
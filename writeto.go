@@ -0,0 +1,63 @@
+package bstree
+
+import (
+	"fmt"
+	"io"
+)
+
+// Encoder writes a single value of the tree to w
+type Encoder func(w io.Writer, value interface{}) error
+
+// LineEncoder writes each value on its own line using the default fmt formatting
+func LineEncoder(w io.Writer, value interface{}) error {
+	_, err := fmt.Fprintln(w, value)
+	return err
+}
+
+// WithEncoder sets the encoder used by WriteTo
+// The default is LineEncoder.
+func WithEncoder(encoder Encoder) Option {
+	return func(tree *Tree) {
+		tree.encoder = encoder
+	}
+}
+
+// _CountingWriter counts the bytes written through it
+type _CountingWriter struct {
+	writer io.Writer
+	count  int64
+}
+
+func (cw *_CountingWriter) Write(p []byte) (int, error) {
+	n, err := cw.writer.Write(p)
+	cw.count += int64(n)
+	return n, err
+}
+
+// WriteTo streams the values of the tree to w in sorted order
+// It implements io.WriterTo and stops at the first error.
+// Time-complexity: O(size)
+func (tree *Tree) WriteTo(w io.Writer) (int64, error) {
+	tree.rlock()
+	defer tree.mutex.RUnlock()
+	encoder := tree.encoder
+	if encoder == nil {
+		encoder = LineEncoder
+	}
+	cw := &_CountingWriter{writer: w}
+	err := tree.doWriteTo(tree.root, cw, encoder)
+	return cw.count, err
+}
+
+func (tree *Tree) doWriteTo(node *_Node, w io.Writer, encoder Encoder) error {
+	if node == nil {
+		return nil
+	}
+	if err := tree.doWriteTo(node.left, w, encoder); err != nil {
+		return err
+	}
+	if err := encoder(w, node.value); err != nil {
+		return err
+	}
+	return tree.doWriteTo(node.right(), w, encoder)
+}
@@ -0,0 +1,57 @@
+package bstree
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+)
+
+func TestTree_WriteToCount(t *testing.T) {
+	var buffer bytes.Buffer
+	tree := CompleteTree(100)
+	n, err := tree.WriteTo(&buffer)
+	if err != nil {
+		t.Fatalf("WriteTo: {Expected: nil | Actual: %v}", err)
+	}
+	if int64(buffer.Len()) != n {
+		t.Errorf("WriteTo: {Expected: %d | Actual: %d}", buffer.Len(), n)
+	}
+}
+
+func TestTree_WriteToError(t *testing.T) {
+	failure := errors.New("failure")
+	count := 0
+	tree := New(IntSmaller, IntLarger, WithEncoder(func(w io.Writer, value interface{}) error {
+		count++
+		if value.(int) == 5 {
+			return failure
+		}
+		return nil
+	}))
+	for i := 1; i <= 10; i++ {
+		tree.Insert(i)
+	}
+	if _, err := tree.WriteTo(io.Discard); err != failure {
+		t.Errorf("WriteTo: {Expected: %v | Actual: %v}", failure, err)
+	}
+	if expected := 5; expected != count {
+		t.Errorf("Encoded values: {Expected: %d | Actual: %d}", expected, count)
+	}
+}
+
+// Stream the tree in sorted order using a custom encoder
+func ExampleTree_WriteTo() {
+	tree := New(IntSmaller, IntLarger, WithEncoder(func(w io.Writer, value interface{}) error {
+		_, err := fmt.Fprintf(w, "%d;", value)
+		return err
+	}))
+	tree.Insert(3)
+	tree.Insert(1)
+	tree.Insert(2)
+	tree.WriteTo(os.Stdout)
+	// Output:
+	// 1;2;3;
+}